@@ -0,0 +1,108 @@
+package go_pretty_print
+
+import (
+	"strings"
+	"time"
+)
+
+// Approximation holds the thresholds of the Approximate Style.
+type Approximation struct {
+	// Smallest is the smallest unit used, e.g. "less than 1 minute" is printed below one of it.
+	Smallest DurationUnit
+	// Few is the duration below which e.g. "a few seconds" is printed. Zero disables that phrase.
+	Few Duration
+	// Over and Almost are the fractions of a unit from which on e.g. "over 2 days" and "almost 3 days"
+	// instead of "about 2 days" are printed. Almost also applies to the next larger unit, e.g. "almost 1 week".
+	// Zero disables the respective phrase.
+	Over, Almost float64
+}
+
+// DefaultApproximation returns the Approximation Formatters use by default.
+func DefaultApproximation() Approximation {
+	return Approximation{Smallest: UnitMinute, Few: Duration(10 * time.Second), Over: .25, Almost: .75}
+}
+
+func (f Formatter) appendApproximate(dst []byte, d Duration, units []DurationUnit, locale *Locale) []byte {
+	a := DefaultApproximation()
+	if f.approximation != nil {
+		a = *f.approximation
+	}
+
+	if locale.Approximate.About == "" {
+		locale = localeEnglish
+	}
+
+	negative, abs := d.abs()
+	if negative {
+		dst = append(dst, '-')
+	}
+
+	if abs < uint64(a.Few) && locale.Approximate.Few != "" {
+		return append(dst, locale.Approximate.Few...)
+	}
+
+	if a.Smallest.One > 0 {
+		last := len(units)
+		for last > 1 && units[last-1].One < a.Smallest.One {
+			last--
+		}
+
+		units = units[:last]
+	}
+
+	i := 0
+	for i < len(units) && abs < uint64(units[i].One) {
+		i++
+	}
+
+	var pattern string
+	var unit DurationUnit
+	var amount uint64
+
+	switch {
+	case i > 0 && a.Almost > 0 && float64(abs) >= a.Almost*float64(units[i-1].One):
+		pattern, unit, amount = locale.Approximate.Almost, units[i-1], 1
+	case i == len(units):
+		pattern, unit, amount = locale.Approximate.LessThan, units[i-1], 1
+	default:
+		unit = units[i]
+		amount = abs / uint64(unit.One)
+
+		switch fraction := float64(abs%uint64(unit.One)) / float64(unit.One); {
+		case a.Almost > 0 && fraction >= a.Almost:
+			pattern = locale.Approximate.Almost
+			amount++
+		case a.Over > 0 && fraction >= a.Over:
+			pattern = locale.Approximate.Over
+		default:
+			pattern = locale.Approximate.About
+		}
+	}
+
+	i = strings.Index(pattern, "{0}")
+	if i < 0 {
+		return append(dst, pattern...)
+	}
+
+	dst = append(dst, pattern[:i]...)
+
+	if singular, ok := locale.singular(unit.Symbol, f.relative); ok && amount == 1 {
+		dst = append(dst, singular...)
+	} else {
+		dst = locale.appendUnit(dst, unit, amount, Long, f.relative)
+	}
+
+	return append(dst, pattern[i+3:]...)
+}
+
+// singular returns the phrase for 1 of the unit symbol, if any.
+func (l *Locale) singular(symbol string, relative bool) (string, bool) {
+	if relative {
+		if phrase, ok := l.Relative.Singular[symbol]; ok {
+			return phrase, true
+		}
+	}
+
+	phrase, ok := l.Approximate.Singular[symbol]
+	return phrase, ok
+}
@@ -0,0 +1,98 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestFormatter_Format_Approximate(t *testing.T) {
+	f := NewFormatter().WithStyle(Approximate)
+
+	assertFormatter_Format(t, f, 0, "a few seconds")
+	assertFormatter_Format(t, f, s5, "a few seconds")
+	assertFormatter_Format(t, f, 10*time.Second, "less than a minute")
+	assertFormatter_Format(t, f, 44*time.Second, "less than a minute")
+	assertFormatter_Format(t, f, 45*time.Second, "almost a minute")
+	assertFormatter_Format(t, f, time.Minute, "about a minute")
+	assertFormatter_Format(t, f, m4+s5, "about 4 minutes")
+	assertFormatter_Format(t, f, m4+20*time.Second, "over 4 minutes")
+	assertFormatter_Format(t, f, m4+50*time.Second, "almost 5 minutes")
+	assertFormatter_Format(t, f, 46*time.Minute, "almost an hour")
+	assertFormatter_Format(t, f, time.Hour+m4, "about an hour")
+	assertFormatter_Format(t, f, 17*time.Hour, "about 17 hours")
+	assertFormatter_Format(t, f, 18*time.Hour, "almost a day")
+	assertFormatter_Format(t, f, d2-h3, "almost 2 days")
+	assertFormatter_Format(t, f, d2+h3, "about 2 days")
+	assertFormatter_Format(t, f, d2+7*time.Hour, "over 2 days")
+	assertFormatter_Format(t, f, 5*d2/2, "about 5 days")
+	assertFormatter_Format(t, f, 6*d2/2, "almost a week")
+	assertFormatter_Format(t, f, w1+d2, "over a week")
+	assertFormatter_Format(t, f, -d2-h3, "-about 2 days")
+	assertFormatter_Format(t, f, math.MinInt64, "-over 15250 weeks")
+
+	assertFormatter_Format(t, f.WithUnits(UnitYear, UnitMonth, UnitDay, UnitHour), 400*d2/2, "about a year")
+	assertFormatter_Format(t, f.WithUnits(UnitYear, UnitMonth, UnitDay, UnitHour), 300*d2/2, "almost a year")
+	assertFormatter_Format(t, f.WithUnits(UnitHour), d2, "about 48 hours")
+	assertFormatter_Format(t, f.WithUnits(UnitHour), m4, "less than an hour")
+
+	exact := DefaultApproximation()
+	exact.Smallest = UnitSecond
+	exact.Few = 0
+	exact.Over = .1
+	exact.Almost = .9
+
+	assertFormatter_Format(t, f.WithApproximation(exact), 0, "less than a second")
+	assertFormatter_Format(t, f.WithApproximation(exact), s5, "about 5 seconds")
+	assertFormatter_Format(t, f.WithApproximation(exact), 40*time.Minute, "about 40 minutes")
+	assertFormatter_Format(t, f.WithApproximation(exact), 55*time.Minute, "almost an hour")
+	assertFormatter_Format(t, f.WithApproximation(exact), d2+h3, "over 2 days")
+	assertFormatter_Format(t, f.WithApproximation(Approximation{}), ns8, "about 8 nanoseconds")
+
+	de := f.WithLocale(mustLookupLocale("de"))
+	assertFormatter_Format(t, de, s5, "ein paar Sekunden")
+	assertFormatter_Format(t, de, 30*time.Second, "weniger als eine Minute")
+	assertFormatter_Format(t, de, d2+h3, "etwa 2 Tage")
+	assertFormatter_Format(t, de, d2+7*time.Hour, "über 2 Tage")
+	assertFormatter_Format(t, de, d2-h3, "fast 2 Tage")
+	assertFormatter_Format(t, de, d2/2+h3, "etwa ein Tag")
+
+	assertFormatter_Format(t, f.WithLocale(mustLookupLocale("ru")), d2+h3, "about 2 days")
+}
+
+func TestDuration_Format_Approximate(t *testing.T) {
+	assertDuration_Format(t, d2+h3, "%a", "about 2 days")
+	assertDuration_Format(t, d2+h3, "%15a|", "   about 2 days|")
+	assertDuration_Format(t, d2+h3, "%-15a|", "about 2 days   |")
+	assertDuration_Format(t, -m4, "%a", "-about 4 minutes")
+
+	AssertCallResult(
+		t, "fmt.Sprint(Duration(%v).Styled(Approximate))", []any{h3 - m4}, []any{"almost 3 hours"},
+		[]any{fmt.Sprint(Duration(h3 - m4).Styled(Approximate))},
+	)
+
+	AssertCallResult(
+		t, "fmt.Sprint(Duration(%v).Styled(Approximate))", []any{m4 - h3}, []any{"-almost 3 hours"},
+		[]any{fmt.Sprint(Duration(m4 - h3).Styled(Approximate))},
+	)
+
+	AssertCallResult(
+		t, "fmt.Sprintf(\"%%a\", Duration(%v).Localized(de))", []any{w1 - d2/2}, []any{"fast eine Woche"},
+		[]any{fmt.Sprintf("%a", Duration(w1-d2/2).Localized(mustLookupLocale("de")))},
+	)
+}
+
+func TestRelativeFormatter_Approximate(t *testing.T) {
+	rf := NewRelativeFormatter().WithClock(fixedClock()).WithFormatter(NewFormatter().WithStyle(Approximate))
+
+	assertRelativeFormatter_Format(t, rf, -d2-7*time.Hour, "over 2 days ago")
+	assertRelativeFormatter_Format(t, rf, 46*time.Minute, "in almost an hour")
+	assertRelativeFormatter_Format(t, rf.WithJustNow(0), s5, "in a few seconds")
+
+	de := NewFormatter().WithStyle(Approximate).WithLocale(mustLookupLocale("de"))
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de), -d2-h3, "vor etwa 2 Tagen")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de), -d2/2, "vor etwa einem Tag")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de), 46*time.Minute, "in fast einer Stunde")
+}
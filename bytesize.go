@@ -0,0 +1,231 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// ByteSize is an amount of bytes printed like "1GiB 512MiB".
+type ByteSize int64
+
+// ByteUnit is something a ByteSize can be broken down into.
+type ByteUnit = Unit[ByteSize]
+
+var (
+	UnitByte     = ByteUnit{Symbol: "B", One: 1}
+	UnitKibibyte = ByteUnit{Symbol: "KiB", One: 1 << 10}
+	UnitMebibyte = ByteUnit{Symbol: "MiB", One: 1 << 20}
+	UnitGibibyte = ByteUnit{Symbol: "GiB", One: 1 << 30}
+	UnitTebibyte = ByteUnit{Symbol: "TiB", One: 1 << 40}
+	UnitPebibyte = ByteUnit{Symbol: "PiB", One: 1 << 50}
+	UnitExbibyte = ByteUnit{Symbol: "EiB", One: 1 << 60}
+	UnitKilobyte = ByteUnit{Symbol: "kB", One: 1e3}
+	UnitMegabyte = ByteUnit{Symbol: "MB", One: 1e6}
+	UnitGigabyte = ByteUnit{Symbol: "GB", One: 1e9}
+	UnitTerabyte = ByteUnit{Symbol: "TB", One: 1e12}
+	UnitPetabyte = ByteUnit{Symbol: "PB", One: 1e15}
+	UnitExabyte  = ByteUnit{Symbol: "EB", One: 1e18}
+)
+
+// byteUnitsIEC are the binary units ByteSize uses by default, byteUnitsSI the decimal ones.
+var byteUnitsIEC = [7]ByteUnit{UnitExbibyte, UnitPebibyte, UnitTebibyte, UnitGibibyte, UnitMebibyte, UnitKibibyte, UnitByte}
+var byteUnitsSI = [7]ByteUnit{UnitExabyte, UnitPetabyte, UnitTerabyte, UnitGigabyte, UnitMegabyte, UnitKilobyte, UnitByte}
+
+// byteSystemIEC and byteSystemSI print byteUnitsIEC and byteUnitsSI.
+var byteSystemIEC = NewUnitSystem(byteUnitsIEC[:]...).WithoutFractions()
+var byteSystemSI = NewUnitSystem(byteUnitsSI[:]...).WithoutFractions()
+
+// SIByteSize is a ByteSize printed with decimal units like "1GB 500MB" by String and Format.
+type SIByteSize ByteSize
+
+func (sbs SIByteSize) String() string {
+	var buf [64]byte
+	return string(byteSystemSI.AppendSegments(buf[:0], ByteSize(sbs), 2))
+}
+
+func (sbs SIByteSize) Format(f fmt.State, c rune) {
+	ByteSize(sbs).format(f, c, byteSystemSI)
+}
+
+// SI returns bs printed with decimal units.
+func (bs ByteSize) SI() SIByteSize {
+	return SIByteSize(bs)
+}
+
+// String prints at most two segments of binary units, e.g. "1GiB 512MiB".
+func (bs ByteSize) String() string {
+	var buf [64]byte
+	return string(byteSystemIEC.AppendSegments(buf[:0], bs, 2))
+}
+
+// AppendString appends bs with at most the given amount of units (or all if units < 1) to dst.
+func (bs ByteSize) AppendString(dst []byte, units int) []byte {
+	return byteSystemIEC.AppendSegments(dst, bs, units)
+}
+
+// Scaled prints bs in the largest binary unit it fills with the given significant digits (at least 1),
+// e.g. "1.50GiB", like Duration.Scaled.
+func (bs ByteSize) Scaled(digits int) string {
+	var buf [32]byte
+	return string(byteSystemIEC.AppendScaled(buf[:0], bs, digits))
+}
+
+// AppendScaled appends bs like Scaled to dst.
+func (bs ByteSize) AppendScaled(dst []byte, digits int) []byte {
+	return byteSystemIEC.AppendScaled(dst, bs, digits)
+}
+
+// Format works like Duration.Format, i.e. supports these verbs:
+//
+//	%s, %v                    segments like String, the precision is the amount of additional segments
+//	%u                        like Scaled, the precision is the amount of significant digits (default 3)
+//	%b, %e, %E, %f, %g, %G    float bytes
+//	%d, %o, %O, %x, %X        integer bytes
+//	%#v                       Go syntax
+//
+// With the # flag, %s and %u use decimal units.
+func (bs ByteSize) Format(f fmt.State, c rune) {
+	bs.format(f, c, byteSystemIEC)
+}
+
+func (bs ByteSize) format(f fmt.State, c rune, system UnitSystem[ByteSize]) {
+	if c == 'v' && f.Flag('#') {
+		writePadded(f, bs.GoString(), "", false)
+		return
+	}
+
+	if f.Flag('#') {
+		system = byteSystemSI
+	}
+
+	if !system.format(f, c, bs) {
+		fmt.Fprintf(f, "%%!%c(go_pretty_print.ByteSize=%d)", c, int64(bs))
+	}
+}
+
+// GoString prints bs as Go expression, e.g. "go_pretty_print.ByteSize(1536)".
+func (bs ByteSize) GoString() string {
+	return "go_pretty_print.ByteSize(" + strconv.FormatInt(int64(bs), 10) + ")"
+}
+
+// ParseByteSize parses strings like "1GiB 512MiB", "1.5GB" or "1536" (bytes) as produced by ByteSize.String.
+// Binary and decimal units may be mixed, but have to be in descending order.
+func ParseByteSize(s string) (ByteSize, error) {
+	p := parser{input: s}
+	p.skipSpaces()
+
+	if p.done() {
+		return 0, p.fail("empty size")
+	}
+
+	negative := p.sign()
+
+	var sum int64
+	var previous ByteSize
+
+	for {
+		p.skipSpaces()
+
+		if p.done() {
+			break
+		}
+
+		amountOffset := p.offset
+		if _, ok := p.uint(); !ok {
+			return 0, p.fail("expected a number")
+		}
+
+		if !p.done() && p.input[p.offset] == '.' {
+			p.offset++
+
+			if _, ok := p.uint(); !ok {
+				return 0, p.fail("expected a number")
+			}
+		}
+
+		amount := p.input[amountOffset:p.offset]
+		if negative {
+			amount = "-" + amount
+		}
+
+		p.skipSpaces()
+		unitOffset := p.offset
+
+		unit, ok := p.byteUnit()
+		if !ok {
+			p.offset = unitOffset
+			return 0, p.fail("unknown unit")
+		}
+
+		if previous != 0 && unit.One >= previous {
+			p.offset = unitOffset
+			return 0, p.fail("unit out of order")
+		}
+
+		previous = unit.One
+
+		n, err := parseScaled(amount, int64(unit.One), "size")
+		if err == nil && (n > 0 && sum > math.MaxInt64-n || n < 0 && sum < math.MinInt64-n) {
+			err = &ParseError{Msg: "size out of range"}
+		}
+
+		if err != nil {
+			p.offset = amountOffset
+			return 0, p.fail(err.(*ParseError).Msg)
+		}
+
+		sum += n
+	}
+
+	if previous == 0 {
+		return 0, p.fail("expected a number")
+	}
+
+	return ByteSize(sum), nil
+}
+
+// byteUnit parses a unit symbol, a missing one means bytes.
+func (p *parser) byteUnit() (ByteUnit, bool) {
+	word := p.word()
+	if word == "" {
+		return UnitByte, true
+	}
+
+	for _, units := range [2]*[7]ByteUnit{&byteUnitsIEC, &byteUnitsSI} {
+		for _, unit := range units {
+			if unit.Symbol == word {
+				return unit, true
+			}
+		}
+	}
+
+	return ByteUnit{}, false
+}
+
+// MarshalJSON writes an integer of bytes.
+func (bs ByteSize) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(bs), 10), nil
+}
+
+// UnmarshalJSON accepts numbers of bytes (as written by MarshalJSON) and strings like ParseByteSize.
+func (bs *ByteSize) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONValue(data, bs, func(s string) (ByteSize, error) {
+		n, err := parseScaled(s, 1, "size")
+		return ByteSize(n), err
+	}, ParseByteSize)
+}
+
+// MarshalText writes all segments, unlike String.
+func (bs ByteSize) MarshalText() ([]byte, error) {
+	return byteSystemIEC.AppendSegments(nil, bs, 0), nil
+}
+
+func (bs *ByteSize) UnmarshalText(text []byte) error {
+	size, err := ParseByteSize(string(text))
+	if err == nil {
+		*bs = size
+	}
+
+	return err
+}
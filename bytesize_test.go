@@ -0,0 +1,185 @@
+package go_pretty_print
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+)
+
+const kib = 1 << 10
+const mib = 1 << 20
+const gib = 1 << 30
+
+func TestByteSize_String(t *testing.T) {
+	assertByteSize_String(t, 0, "0B", "0B")
+	assertByteSize_String(t, 512, "512B", "512B")
+	assertByteSize_String(t, 1536, "1KiB 512B", "1kB 536B")
+	assertByteSize_String(t, gib+512*mib+kib, "1GiB 512MiB", "1GB 610MB")
+	assertByteSize_String(t, -gib, "-1GiB", "-1GB 73MB")
+	assertByteSize_String(t, 3e9, "2GiB 813MiB", "3GB")
+	assertByteSize_String(t, math.MaxInt64, "7EiB 1023PiB", "9EB 223PB")
+	assertByteSize_String(t, math.MinInt64, "-8EiB", "-9EB 223PB")
+}
+
+func assertByteSize_String(t *testing.T, bs ByteSize, iec, si string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "ByteSize(%d).String()", []any{int64(bs)}, []any{iec, si},
+		[]any{bs.String(), bs.SI().String()},
+	)
+}
+
+func TestByteSize_AppendString(t *testing.T) {
+	AssertCallResult(
+		t, "ByteSize(%d).AppendString(%#v, 0)", []any{gib + 512*mib + kib, "size="}, []any{"size=1GiB 512MiB 1KiB"},
+		[]any{string(ByteSize(gib+512*mib+kib).AppendString([]byte("size="), 0))},
+	)
+}
+
+func TestByteSize_Scaled(t *testing.T) {
+	assertByteSize_Scaled(t, 0, 3, "0B")
+	assertByteSize_Scaled(t, 8, 3, "8B")
+	assertByteSize_Scaled(t, 1023, 3, "1023B")
+	assertByteSize_Scaled(t, 1536, 3, "1.50KiB")
+	assertByteSize_Scaled(t, gib+512*mib, 2, "1.5GiB")
+	assertByteSize_Scaled(t, 1023*kib+1000, 3, "1.00MiB")
+	assertByteSize_Scaled(t, -10*mib, 3, "-10.0MiB")
+	assertByteSize_Scaled(t, math.MaxInt64, 3, "8.00EiB")
+}
+
+func assertByteSize_Scaled(t *testing.T, bs ByteSize, digits int, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "ByteSize(%d).Scaled(%d)", []any{int64(bs), digits}, []any{expected}, []any{bs.Scaled(digits)})
+}
+
+func TestByteSize_Format(t *testing.T) {
+	assertByteSize_Format(t, 1536, "%v", "1KiB 512B")
+	assertByteSize_Format(t, 1536, "%s", "1KiB 512B")
+	assertByteSize_Format(t, gib+512*mib+kib, "%.0s", "1GiB")
+	assertByteSize_Format(t, gib+512*mib+kib, "%.2v", "1GiB 512MiB 1KiB")
+	assertByteSize_Format(t, 1536, "%#s", "1kB 536B")
+	assertByteSize_Format(t, 1536, "%12s|", "   1KiB 512B|")
+	assertByteSize_Format(t, 1536, "%-12s|", "1KiB 512B   |")
+	assertByteSize_Format(t, 1536, "%+s", "+1KiB 512B")
+	assertByteSize_Format(t, 1536, "%u", "1.50KiB")
+	assertByteSize_Format(t, 1536, "%.2u", "1.5KiB")
+	assertByteSize_Format(t, 1536, "%#u", "1.54kB")
+	assertByteSize_Format(t, 1536, "%08u", "01.50KiB")
+	assertByteSize_Format(t, 1536, "%d", "1536")
+	assertByteSize_Format(t, -1536, "%x", "-600")
+	assertByteSize_Format(t, 1536, "%#X", "0X600")
+	assertByteSize_Format(t, 1536, "%O", "0o3000")
+	assertByteSize_Format(t, 1536, "%08d", "00001536")
+	assertByteSize_Format(t, 1536, "%.1f", "1536.0")
+	assertByteSize_Format(t, 1536, "%e", "1.536e+03")
+	assertByteSize_Format(t, 1536, "%#v", "go_pretty_print.ByteSize(1536)")
+	assertByteSize_Format(t, -1536, "%#v", "go_pretty_print.ByteSize(-1536)")
+	assertByteSize_Format(t, 1536, "%q", "%!q(go_pretty_print.ByteSize=1536)")
+
+	AssertCallResult(
+		t, "fmt.Sprintf(%#v, ByteSize(%d).SI())", []any{"%u", 1536}, []any{"1.54kB"},
+		[]any{fmt.Sprintf("%u", ByteSize(1536).SI())},
+	)
+}
+
+func assertByteSize_Format(t *testing.T, bs ByteSize, format, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "fmt.Sprintf(%#v, ByteSize(%d))", []any{format, int64(bs)}, []any{expected},
+		[]any{fmt.Sprintf(format, bs)},
+	)
+}
+
+func TestParseByteSize(t *testing.T) {
+	assertParseByteSize(t, "0", 0)
+	assertParseByteSize(t, "0B", 0)
+	assertParseByteSize(t, "1536", 1536)
+	assertParseByteSize(t, " 1KiB 512B ", 1536)
+	assertParseByteSize(t, "1KiB512", 1536)
+	assertParseByteSize(t, "1.5 KiB", 1536)
+	assertParseByteSize(t, "1.5GB", 1500000000)
+	assertParseByteSize(t, "1GiB 5MB", gib+5e6)
+	assertParseByteSize(t, "-1GiB 512MiB", -gib-512*mib)
+	assertParseByteSize(t, "+1.50KiB", 1536)
+	assertParseByteSize(t, "0.5B", 1)
+	assertParseByteSize(t, "7EiB 1023PiB 1023TiB 1023GiB 1023MiB 1023KiB 1023B", math.MaxInt64)
+	assertParseByteSize(t, "-8EiB", math.MinInt64)
+
+	for _, bs := range [...]ByteSize{1, 1536, gib + 512*mib + kib, 3e9, math.MaxInt64, math.MinInt64} {
+		text, _ := bs.MarshalText()
+		assertParseByteSize(t, string(text), bs)
+	}
+}
+
+func assertParseByteSize(t *testing.T, s string, expected ByteSize) {
+	t.Helper()
+
+	bs, err := ParseByteSize(s)
+	AssertCallResult(t, "ParseByteSize(%#v)", []any{s}, []any{expected, nil}, []any{bs, err})
+}
+
+func TestParseByteSize_Error(t *testing.T) {
+	assertParseByteSize_Error(t, "", 0, "empty size")
+	assertParseByteSize_Error(t, "  ", 2, "empty size")
+	assertParseByteSize_Error(t, "-", 1, "expected a number")
+	assertParseByteSize_Error(t, "KiB", 0, "expected a number")
+	assertParseByteSize_Error(t, "1.KiB", 2, "expected a number")
+	assertParseByteSize_Error(t, "1XB", 1, "unknown unit")
+	assertParseByteSize_Error(t, "1 kiB", 2, "unknown unit")
+	assertParseByteSize_Error(t, "1B 1KiB", 4, "unit out of order")
+	assertParseByteSize_Error(t, "1kB 1KiB", 5, "unit out of order")
+	assertParseByteSize_Error(t, "1 2", 3, "unit out of order")
+	assertParseByteSize_Error(t, "8EiB", 0, "size out of range")
+	assertParseByteSize_Error(t, "7EiB 1024PiB", 5, "size out of range")
+}
+
+func assertParseByteSize_Error(t *testing.T, s string, offset int, msg string) {
+	t.Helper()
+
+	bs, err := ParseByteSize(s)
+	AssertCallResult(t, "ParseByteSize(%#v)", []any{s}, []any{ByteSize(0), &ParseError{s, offset, msg}}, []any{bs, err})
+}
+
+func TestByteSize_JSON(t *testing.T) {
+	type disk struct {
+		Free ByteSize `json:"free"`
+	}
+
+	jsn, err := json.Marshal(disk{gib + 512*mib})
+	AssertCallResult(t, "json.Marshal(%d)", []any{gib + 512*mib}, []any{`{"free":1610612736}`, nil}, []any{string(jsn), err})
+
+	for _, c := range [...]struct {
+		json     string
+		expected ByteSize
+	}{
+		{`{"free":1610612736}`, gib + 512*mib},
+		{`{"free":1.5e3}`, 1500},
+		{`{"free":"1.5GiB"}`, gib + 512*mib},
+		{`{"free":null}`, 42},
+	} {
+		d := disk{42}
+		err := json.Unmarshal([]byte(c.json), &d)
+		AssertCallResult(t, "json.Unmarshal(%#v)", []any{c.json}, []any{c.expected, nil}, []any{d.Free, err})
+	}
+
+	var d disk
+	err = json.Unmarshal([]byte(`{"free":"1 XB"}`), &d)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"free":"1 XB"}`}, []any{&ParseError{"1 XB", 2, "unknown unit"}}, []any{err})
+
+	_, isTypeError := json.Unmarshal([]byte(`{"free":[]}`), &d).(*json.UnmarshalTypeError)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"free":[]}`}, []any{true}, []any{isTypeError})
+}
+
+func TestByteSize_Text(t *testing.T) {
+	text, err := ByteSize(gib + 512*mib + kib).MarshalText()
+	AssertCallResult(t, "ByteSize(%d).MarshalText()", []any{gib + 512*mib + kib}, []any{"1GiB 512MiB 1KiB", nil}, []any{string(text), err})
+
+	var bs ByteSize
+	err = bs.UnmarshalText([]byte("2MB"))
+	AssertCallResult(t, "ByteSize.UnmarshalText(%#v)", []any{"2MB"}, []any{ByteSize(2e6), nil}, []any{bs, err})
+}
@@ -0,0 +1,175 @@
+package go_pretty_print
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Clock prints dur like a stopwatch, e.g. "26:03:07.250" with 3 fractional digits (at most 9)
+// or "1d 02:03:07" with days. The fractional part is truncated.
+func (dur Duration) Clock(digits int, days bool) string {
+	negative, abs := dur.abs()
+	var sb strings.Builder
+
+	if negative {
+		sb.WriteByte('-')
+	}
+
+	hours := abs / uint64(time.Hour)
+
+	if days && hours >= 24 {
+		sb.WriteString(strconv.FormatUint(hours/24, 10))
+		sb.WriteString("d ")
+		writeTwoDigits(&sb, hours%24)
+	} else {
+		sb.WriteString(strconv.FormatUint(hours, 10))
+	}
+
+	sb.WriteByte(':')
+	writeTwoDigits(&sb, abs/uint64(time.Minute)%60)
+	sb.WriteByte(':')
+	writeTwoDigits(&sb, abs/uint64(time.Second)%60)
+
+	if digits > 9 {
+		digits = 9
+	}
+
+	if digits > 0 {
+		fraction := strconv.FormatUint(abs%uint64(time.Second)+uint64(time.Second), 10)
+
+		sb.WriteByte('.')
+		sb.WriteString(fraction[1 : 1+digits])
+	}
+
+	return sb.String()
+}
+
+func writeTwoDigits(sb *strings.Builder, n uint64) {
+	sb.WriteByte(byte('0' + n/10))
+	sb.WriteByte(byte('0' + n%10))
+}
+
+// ParseClock parses strings like "-26:03:07.250" or "1d 02:03:07" as produced by Duration.Clock.
+func ParseClock(s string) (Duration, error) {
+	p := parser{input: s}
+
+	if p.done() {
+		return 0, p.fail("empty duration")
+	}
+
+	negative := p.sign()
+	limit := uint64(math.MaxInt64)
+	if negative {
+		limit++
+	}
+
+	var abs uint64
+	hoursOffset := p.offset
+
+	hours, ok := p.uint()
+	if !ok {
+		return 0, p.fail("expected a number")
+	}
+
+	if !p.done() && p.input[p.offset] == 'd' {
+		p.offset++
+		if p.done() || p.input[p.offset] != ' ' {
+			return 0, p.fail("expected a space")
+		}
+
+		p.offset++
+		if hours > limit/uint64(24*time.Hour) {
+			p.offset = hoursOffset
+			return 0, p.fail("duration out of range")
+		}
+
+		abs = hours * uint64(24*time.Hour)
+		hoursOffset = p.offset
+
+		if hours, ok = p.twoDigits(); !ok {
+			return 0, p.fail("expected two digits")
+		}
+
+		if hours > 23 {
+			p.offset = hoursOffset
+			return 0, p.fail("hours out of range")
+		}
+	}
+
+	if hours > (limit-abs)/uint64(time.Hour) {
+		p.offset = hoursOffset
+		return 0, p.fail("duration out of range")
+	}
+
+	abs += hours * uint64(time.Hour)
+
+	for _, unit := range [2]time.Duration{time.Minute, time.Second} {
+		if p.done() || p.input[p.offset] != ':' {
+			return 0, p.fail("expected a colon")
+		}
+
+		p.offset++
+		offset := p.offset
+
+		amount, ok := p.twoDigits()
+		if !ok {
+			return 0, p.fail("expected two digits")
+		}
+
+		if amount > 59 {
+			p.offset = offset
+			return 0, p.fail("minutes or seconds out of range")
+		}
+
+		abs += amount * uint64(unit)
+	}
+
+	if !p.done() && p.input[p.offset] == '.' {
+		p.offset++
+		var fraction uint64
+		digits := 0
+
+		for ; !p.done() && isDigit(p.input[p.offset]); p.offset++ {
+			if digits++; digits > 9 {
+				return 0, p.fail("too many fractional digits")
+			}
+
+			fraction = fraction*10 + uint64(p.input[p.offset]-'0')
+		}
+
+		if digits == 0 {
+			return 0, p.fail("expected a number")
+		}
+
+		for ; digits < 9; digits++ {
+			fraction *= 10
+		}
+
+		abs += fraction
+	}
+
+	if !p.done() {
+		return 0, p.fail("unexpected trailing characters")
+	}
+
+	if abs > limit {
+		return 0, &ParseError{s, 0, "duration out of range"}
+	}
+
+	if negative {
+		abs = -abs
+	}
+
+	return Duration(abs), nil
+}
+
+func (p *parser) twoDigits() (uint64, bool) {
+	if p.offset+2 > len(p.input) || !isDigit(p.input[p.offset]) || !isDigit(p.input[p.offset+1]) {
+		return 0, false
+	}
+
+	p.offset += 2
+	return uint64(p.input[p.offset-2]-'0')*10 + uint64(p.input[p.offset-1]-'0'), true
+}
@@ -0,0 +1,103 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDuration_Clock(t *testing.T) {
+	d := 26*time.Hour + 3*time.Minute + 7*time.Second + 250*time.Millisecond
+
+	assertDuration_Clock(t, 0, 0, false, "0:00:00")
+	assertDuration_Clock(t, 0, 3, true, "0:00:00.000")
+	assertDuration_Clock(t, d, 0, false, "26:03:07")
+	assertDuration_Clock(t, d, 3, false, "26:03:07.250")
+	assertDuration_Clock(t, d, 1, false, "26:03:07.2")
+	assertDuration_Clock(t, d, 9, false, "26:03:07.250000000")
+	assertDuration_Clock(t, d, 42, false, "26:03:07.250000000")
+	assertDuration_Clock(t, d, 0, true, "1d 02:03:07")
+	assertDuration_Clock(t, d, 3, true, "1d 02:03:07.250")
+	assertDuration_Clock(t, -d, 3, false, "-26:03:07.250")
+	assertDuration_Clock(t, -d, 0, true, "-1d 02:03:07")
+	assertDuration_Clock(t, h3+m4+s5+ms6+us7+ns8, 9, true, "3:04:05.006007008")
+	assertDuration_Clock(t, 999*time.Millisecond, 2, false, "0:00:00.99")
+	assertDuration_Clock(t, math.MaxInt64, 9, true, "106751d 23:47:16.854775807")
+	assertDuration_Clock(t, math.MinInt64, 9, false, "-2562047:47:16.854775808")
+}
+
+func assertDuration_Clock(t *testing.T, d time.Duration, digits int, days bool, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "Duration(%v).Clock(%d, %v)", []any{d, digits, days}, []any{expected},
+		[]any{Duration(d).Clock(digits, days)},
+	)
+}
+
+func TestDuration_Format_Clock(t *testing.T) {
+	d := 26*time.Hour + 3*time.Minute + 7*time.Second + 250*time.Millisecond
+
+	assertDuration_Format(t, d, "%c", "26:03:07")
+	assertDuration_Format(t, d, "%.3c", "26:03:07.250")
+	assertDuration_Format(t, d, "%#c", "1d 02:03:07")
+	assertDuration_Format(t, -d, "%#.2c", "-1d 02:03:07.25")
+
+	AssertCallResult(
+		t, "fmt.Sprintf(%#v, Duration(%v).Rounded(HalfUp))", []any{"%.1c", d}, []any{"26:03:07.2"},
+		[]any{fmt.Sprintf("%.1c", Duration(d).Rounded(HalfUp))},
+	)
+}
+
+func TestParseClock(t *testing.T) {
+	assertParseClock(t, "0:00:00", 0)
+	assertParseClock(t, "-0:00:00.000", 0)
+	assertParseClock(t, "26:03:07", 26*time.Hour+3*time.Minute+7*time.Second)
+	assertParseClock(t, "26:03:07.250", 26*time.Hour+3*time.Minute+7*time.Second+250*time.Millisecond)
+	assertParseClock(t, "1d 02:03:07", 26*time.Hour+3*time.Minute+7*time.Second)
+	assertParseClock(t, "-1d 02:03:07.25", -26*time.Hour-3*time.Minute-7*time.Second-250*time.Millisecond)
+	assertParseClock(t, "+03:04:05.006007008", h3+m4+s5+ms6+us7+ns8)
+	assertParseClock(t, "106751d 23:47:16.854775807", math.MaxInt64)
+	assertParseClock(t, "-2562047:47:16.854775808", math.MinInt64)
+
+	for _, d := range [...]time.Duration{0, ns8, us7, ms6, s5, m4, h3, d2, w1, math.MaxInt64, math.MinInt64} {
+		for _, days := range [2]bool{false, true} {
+			assertParseClock(t, Duration(d).Clock(9, days), d)
+			assertParseClock(t, Duration(-d).Clock(9, days), -d)
+		}
+	}
+}
+
+func assertParseClock(t *testing.T, s string, expected time.Duration) {
+	t.Helper()
+
+	d, err := ParseClock(s)
+	AssertCallResult(t, "ParseClock(%#v)", []any{s}, []any{Duration(expected), nil}, []any{d, err})
+}
+
+func TestParseClock_Error(t *testing.T) {
+	assertParseClock_Error(t, "", 0, "empty duration")
+	assertParseClock_Error(t, "-", 1, "expected a number")
+	assertParseClock_Error(t, "1", 1, "expected a colon")
+	assertParseClock_Error(t, "1:2:03", 2, "expected two digits")
+	assertParseClock_Error(t, "1:60:00", 2, "minutes or seconds out of range")
+	assertParseClock_Error(t, "1:00:60", 5, "minutes or seconds out of range")
+	assertParseClock_Error(t, "1d02:00:00", 2, "expected a space")
+	assertParseClock_Error(t, "1d 2:00:00", 3, "expected two digits")
+	assertParseClock_Error(t, "1d 24:00:00", 3, "hours out of range")
+	assertParseClock_Error(t, "1:00:00.", 8, "expected a number")
+	assertParseClock_Error(t, "1:00:00.1234567890", 17, "too many fractional digits")
+	assertParseClock_Error(t, "1:00:00 ", 7, "unexpected trailing characters")
+	assertParseClock_Error(t, "106751d 23:47:16.854775808", 0, "duration out of range")
+	assertParseClock_Error(t, "106752d 00:00:00", 0, "duration out of range")
+	assertParseClock_Error(t, "2562048:00:00", 0, "duration out of range")
+}
+
+func assertParseClock_Error(t *testing.T, s string, offset int, msg string) {
+	t.Helper()
+
+	d, err := ParseClock(s)
+	AssertCallResult(t, "ParseClock(%#v)", []any{s}, []any{Duration(0), &ParseError{s, offset, msg}}, []any{d, err})
+}
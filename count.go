@@ -0,0 +1,247 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+)
+
+// Count is a dimensionless number printed with SI prefixes like "1.23k" or "56.0µ".
+type Count float64
+
+// SIPrefix is a power of 1000, e.g. PrefixKilo (1) or PrefixMicro (-2).
+type SIPrefix int8
+
+const (
+	PrefixYocto SIPrefix = iota - 8
+	PrefixZepto
+	PrefixAtto
+	PrefixFemto
+	PrefixPico
+	PrefixNano
+	PrefixMicro
+	PrefixMilli
+	PrefixNone
+	PrefixKilo
+	PrefixMega
+	PrefixGiga
+	PrefixTera
+	PrefixPeta
+	PrefixExa
+	PrefixZetta
+	PrefixYotta
+)
+
+var siPrefixSymbols = [17]string{"y", "z", "a", "f", "p", "n", "µ", "m", "", "k", "M", "G", "T", "P", "E", "Z", "Y"}
+
+// countSystem has a unit per SIPrefix, from PrefixYotta down to PrefixYocto.
+var countSystem = func() UnitSystem[Count] {
+	units := make([]Unit[Count], 0, len(siPrefixSymbols))
+	for prefix := PrefixYotta; prefix >= PrefixYocto; prefix-- {
+		units = append(units, Unit[Count]{Symbol: prefix.String(), One: Count(math.Pow(1000, float64(prefix)))})
+	}
+
+	return NewUnitSystem(units...)
+}()
+
+// siPrefixAliases are accepted by ParseCount, too.
+var siPrefixAliases = map[string]SIPrefix{"u": PrefixMicro, "μ": PrefixMicro}
+
+func (sp SIPrefix) String() string {
+	if sp < PrefixYocto || sp > PrefixYotta {
+		return "SIPrefix(" + strconv.Itoa(int(sp)) + ")"
+	}
+
+	return siPrefixSymbols[sp-PrefixYocto]
+}
+
+// PrefixedCount is a Count printed with prefixes from Min to Max only by String and Format.
+type PrefixedCount struct {
+	Count
+	Min, Max SIPrefix
+}
+
+func (pc PrefixedCount) String() string {
+	return pc.Count.prefixed(3, pc.Min, pc.Max)
+}
+
+func (pc PrefixedCount) Format(f fmt.State, c rune) {
+	pc.Count.format(f, c, pc.Min, pc.Max)
+}
+
+// Prefixes returns c printed with prefixes from min to max only, e.g. PrefixNone and PrefixGiga.
+func (c Count) Prefixes(min, max SIPrefix) PrefixedCount {
+	return PrefixedCount{c, min, max}
+}
+
+// String prints c with 3 significant digits.
+func (c Count) String() string {
+	return c.prefixed(3, PrefixYocto, PrefixYotta)
+}
+
+// Format works like Duration.Format, i.e. supports these verbs:
+//
+//	%s, %v, %u                like String, the precision is the amount of significant digits
+//	%b, %e, %E, %f, %g, %G    raw float
+//	%#v                       Go syntax
+func (c Count) Format(f fmt.State, r rune) {
+	c.format(f, r, PrefixYocto, PrefixYotta)
+}
+
+func (c Count) format(f fmt.State, r rune, min, max SIPrefix) {
+	switch r {
+	case 'b', 'e', 'E', 'f', 'g', 'G':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = -1
+		}
+
+		writePadded(f, strconv.FormatFloat(float64(c), byte(r), prec, 64), "", f.Flag('0'))
+	case 'v':
+		if f.Flag('#') {
+			writePadded(f, c.GoString(), "", false)
+			return
+		}
+
+		fallthrough
+	case 's', 'u':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = 3
+		}
+
+		writePadded(f, c.prefixed(prec, min, max), "", f.Flag('0'))
+	default:
+		fmt.Fprintf(f, "%%!%c(go_pretty_print.Count=%s)", r, strconv.FormatFloat(float64(c), 'g', -1, 64))
+	}
+}
+
+// GoString prints c as Go expression, e.g. "go_pretty_print.Count(1234.5)".
+func (c Count) GoString() string {
+	return "go_pretty_print.Count(" + strconv.FormatFloat(float64(c), 'g', -1, 64) + ")"
+}
+
+func (c Count) prefixed(digits int, min, max SIPrefix) string {
+	if min < PrefixYocto {
+		min = PrefixYocto
+	}
+
+	if max > PrefixYotta {
+		max = PrefixYotta
+	}
+
+	abs := math.Abs(float64(c))
+	if abs == 0 || math.IsInf(abs, 0) || math.IsNaN(abs) || min > max {
+		return strconv.FormatFloat(float64(c), 'g', -1, 64)
+	}
+
+	return countSystem.withUnits(countSystem.units[PrefixYotta-max:PrefixYotta-min+1]).Scaled(c, digits)
+}
+
+// ParseCount parses strings like "1.2k", "-56µ", "56 u" or "1e3" as produced by Count.String.
+func ParseCount(s string) (Count, error) {
+	p := parser{input: s}
+	p.skipSpaces()
+
+	if p.done() {
+		return 0, p.fail("empty count")
+	}
+
+	start := p.offset
+	p.sign()
+
+	if _, ok := p.uint(); !ok {
+		return 0, p.fail("expected a number")
+	}
+
+	if !p.done() && p.input[p.offset] == '.' {
+		p.offset++
+
+		if _, ok := p.uint(); !ok {
+			return 0, p.fail("expected a number")
+		}
+	}
+
+	mantissa := p.input[start:p.offset]
+	exponent := 0
+
+	// "E" may be the exa prefix, too.
+	if rest := p.input[p.offset:]; len(rest) > 1 && (rest[0] == 'e' || rest[0] == 'E') &&
+		(isDigit(rest[1]) || (rest[1] == '+' || rest[1] == '-') && len(rest) > 2 && isDigit(rest[2])) {
+		p.offset++
+		negative := p.sign()
+		e, _ := p.uint()
+
+		if e > math.MaxInt32 {
+			e = math.MaxInt32
+		}
+
+		if exponent = int(e); negative {
+			exponent = -exponent
+		}
+	}
+
+	p.skipSpaces()
+	prefixOffset := p.offset
+
+	prefix := PrefixNone
+	if word := p.word(); word != "" {
+		var ok bool
+		if prefix, ok = lookupSIPrefix(word); !ok {
+			p.offset = prefixOffset
+			return 0, p.fail("unknown prefix")
+		}
+	}
+
+	p.skipSpaces()
+	if !p.done() {
+		return 0, p.fail("unexpected trailing characters")
+	}
+
+	// The syntax is valid, so the only possible error is strconv.ErrRange. Underflows become zero.
+	f, err := strconv.ParseFloat(mantissa+"e"+strconv.Itoa(exponent+3*int(prefix)), 64)
+	if err != nil && math.IsInf(f, 0) {
+		p.offset = start
+		return 0, p.fail("count out of range")
+	}
+
+	return Count(f), nil
+}
+
+func lookupSIPrefix(symbol string) (SIPrefix, bool) {
+	for i, s := range siPrefixSymbols {
+		if s == symbol {
+			return SIPrefix(i) + PrefixYocto, true
+		}
+	}
+
+	prefix, ok := siPrefixAliases[symbol]
+	return prefix, ok
+}
+
+// MarshalJSON writes a number like Duration.MarshalJSON.
+func (c Count) MarshalJSON() ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(c), 'g', -1, 64), nil
+}
+
+// UnmarshalJSON accepts numbers (as written by MarshalJSON) and strings like ParseCount.
+func (c *Count) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONValue(data, c, func(s string) (Count, error) {
+		f, err := strconv.ParseFloat(s, 64)
+		return Count(f), err
+	}, ParseCount)
+}
+
+// MarshalText writes c without prefix, but losslessly like strconv.FormatFloat.
+func (c Count) MarshalText() ([]byte, error) {
+	return strconv.AppendFloat(nil, float64(c), 'g', -1, 64), nil
+}
+
+func (c *Count) UnmarshalText(text []byte) error {
+	n, err := ParseCount(string(text))
+	if err == nil {
+		*c = n
+	}
+
+	return err
+}
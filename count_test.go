@@ -0,0 +1,176 @@
+package go_pretty_print
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+)
+
+func TestCount_String(t *testing.T) {
+	assertCount_String(t, 0, "0")
+	assertCount_String(t, 1, "1.00")
+	assertCount_String(t, 56, "56.0")
+	assertCount_String(t, 999, "999")
+	assertCount_String(t, 1234, "1.23k")
+	assertCount_String(t, 3.4e6, "3.40M")
+	assertCount_String(t, 56e-6, "56.0µ")
+	assertCount_String(t, 1e-6, "1.00µ")
+	assertCount_String(t, 0.5, "500m")
+	assertCount_String(t, 999.9, "1.00k")
+	assertCount_String(t, 9999, "10.0k")
+	assertCount_String(t, 999999, "1.00M")
+	assertCount_String(t, -1234, "-1.23k")
+	assertCount_String(t, 1.5e24, "1.50Y")
+	assertCount_String(t, 1.5e27, "1500Y")
+	assertCount_String(t, 1e-27, "0.00100y")
+	assertCount_String(t, Count(math.Inf(-1)), "-Inf")
+	assertCount_String(t, Count(math.NaN()), "NaN")
+}
+
+func assertCount_String(t *testing.T, c Count, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Count(%v).String()", []any{float64(c)}, []any{expected}, []any{c.String()})
+}
+
+func TestCount_Prefixes(t *testing.T) {
+	assertCount_Prefixes(t, 1234, PrefixNone, PrefixGiga, "1.23k")
+	assertCount_Prefixes(t, 0.5, PrefixNone, PrefixGiga, "0.500")
+	assertCount_Prefixes(t, 56e-6, PrefixMilli, PrefixNone, "0.0560m")
+	assertCount_Prefixes(t, 3.4e12, PrefixNone, PrefixGiga, "3400G")
+	assertCount_Prefixes(t, 999.9e9, PrefixNone, PrefixGiga, "1000G")
+	assertCount_Prefixes(t, 1234, PrefixNone, PrefixNone, "1234")
+	assertCount_Prefixes(t, 1234, PrefixKilo, PrefixNone, "1234")
+	assertCount_Prefixes(t, 1234, -100, 100, "1.23k")
+}
+
+func assertCount_Prefixes(t *testing.T, c Count, min, max SIPrefix, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "Count(%v).Prefixes(%d, %d).String()", []any{float64(c), min, max}, []any{expected},
+		[]any{c.Prefixes(min, max).String()},
+	)
+}
+
+func TestCount_Format(t *testing.T) {
+	assertCount_Format(t, 1234, "%v", "1.23k")
+	assertCount_Format(t, 1234, "%s", "1.23k")
+	assertCount_Format(t, 1234, "%u", "1.23k")
+	assertCount_Format(t, 1234, "%.2v", "1.2k")
+	assertCount_Format(t, 1234, "%.1s", "1k")
+	assertCount_Format(t, 1234, "%.5s", "1.2340k")
+	assertCount_Format(t, 1234, "%8v|", "   1.23k|")
+	assertCount_Format(t, 1234, "%-8v|", "1.23k   |")
+	assertCount_Format(t, 1234, "%+v", "+1.23k")
+	assertCount_Format(t, -1234, "%08v", "-001.23k")
+	assertCount_Format(t, 1234.5, "%f", "1234.5")
+	assertCount_Format(t, 1234.5, "%.2f", "1234.50")
+	assertCount_Format(t, 1234.5, "%e", "1.2345e+03")
+	assertCount_Format(t, 1234.5, "%g", "1234.5")
+	assertCount_Format(t, 1234.5, "%#v", "go_pretty_print.Count(1234.5)")
+	assertCount_Format(t, 1234.5, "%d", "%!d(go_pretty_print.Count=1234.5)")
+
+	AssertCallResult(
+		t, "fmt.Sprintf(%#v, Count(%v).Prefixes(PrefixNone, PrefixKilo))", []any{"%.2v", 3.4e6}, []any{"3400k"},
+		[]any{fmt.Sprintf("%.2v", Count(3.4e6).Prefixes(PrefixNone, PrefixKilo))},
+	)
+}
+
+func assertCount_Format(t *testing.T, c Count, format, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "fmt.Sprintf(%#v, Count(%v))", []any{format, float64(c)}, []any{expected}, []any{fmt.Sprintf(format, c)})
+}
+
+func TestSIPrefix_String(t *testing.T) {
+	AssertCallResult(
+		t, "SIPrefix.String()", nil, []any{"y", "µ", "", "k", "Y", "SIPrefix(9)"},
+		[]any{PrefixYocto.String(), PrefixMicro.String(), PrefixNone.String(), PrefixKilo.String(), PrefixYotta.String(), SIPrefix(9).String()},
+	)
+}
+
+func TestParseCount(t *testing.T) {
+	assertParseCount(t, "0", 0)
+	assertParseCount(t, "56", 56)
+	assertParseCount(t, "1.2k", 1200)
+	assertParseCount(t, " 1.2 k ", 1200)
+	assertParseCount(t, "+3.4M", 3.4e6)
+	assertParseCount(t, "-56µ", -56e-6)
+	assertParseCount(t, "56μ", 56e-6)
+	assertParseCount(t, "56u", 56e-6)
+	assertParseCount(t, "1E", 1e18)
+	assertParseCount(t, "1e3", 1000)
+	assertParseCount(t, "1E3k", 1e6)
+	assertParseCount(t, "1.5e-3M", 1500)
+	assertParseCount(t, "1e+21", 1e21)
+	assertParseCount(t, "1e-400", 0)
+	assertParseCount(t, "0.1", 0.1)
+
+	for _, c := range [...]Count{1234.5, -56e-6, 1e21, math.MaxFloat64, math.SmallestNonzeroFloat64} {
+		text, _ := c.MarshalText()
+		assertParseCount(t, string(text), c)
+	}
+}
+
+func assertParseCount(t *testing.T, s string, expected Count) {
+	t.Helper()
+
+	c, err := ParseCount(s)
+	AssertCallResult(t, "ParseCount(%#v)", []any{s}, []any{expected, nil}, []any{c, err})
+}
+
+func TestParseCount_Error(t *testing.T) {
+	assertParseCount_Error(t, "", 0, "empty count")
+	assertParseCount_Error(t, "-", 1, "expected a number")
+	assertParseCount_Error(t, "k", 0, "expected a number")
+	assertParseCount_Error(t, "1.k", 2, "expected a number")
+	assertParseCount_Error(t, "1x", 1, "unknown prefix")
+	assertParseCount_Error(t, "1 kk", 2, "unknown prefix")
+	assertParseCount_Error(t, "1e", 1, "unknown prefix")
+	assertParseCount_Error(t, "1k 2", 3, "unexpected trailing characters")
+	assertParseCount_Error(t, "1e400", 0, "count out of range")
+	assertParseCount_Error(t, "-1e308k", 0, "count out of range")
+}
+
+func assertParseCount_Error(t *testing.T, s string, offset int, msg string) {
+	t.Helper()
+
+	c, err := ParseCount(s)
+	AssertCallResult(t, "ParseCount(%#v)", []any{s}, []any{Count(0), &ParseError{s, offset, msg}}, []any{c, err})
+}
+
+func TestCount_JSON(t *testing.T) {
+	type stats struct {
+		Requests Count `json:"requests"`
+	}
+
+	jsn, err := json.Marshal(stats{1234.5})
+	AssertCallResult(t, "json.Marshal(%v)", []any{1234.5}, []any{`{"requests":1234.5}`, nil}, []any{string(jsn), err})
+
+	for _, c := range [...]struct {
+		json     string
+		expected Count
+	}{
+		{`{"requests":1234.5}`, 1234.5},
+		{`{"requests":"1.2k"}`, 1200},
+		{`{"requests":null}`, 42},
+	} {
+		s := stats{42}
+		err := json.Unmarshal([]byte(c.json), &s)
+		AssertCallResult(t, "json.Unmarshal(%#v)", []any{c.json}, []any{c.expected, nil}, []any{s.Requests, err})
+	}
+
+	var s stats
+	err = json.Unmarshal([]byte(`{"requests":"1x"}`), &s)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"requests":"1x"}`}, []any{&ParseError{"1x", 1, "unknown prefix"}}, []any{err})
+
+	_, isTypeError := json.Unmarshal([]byte(`{"requests":true}`), &s).(*json.UnmarshalTypeError)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"requests":true}`}, []any{true}, []any{isTypeError})
+
+	var c Count
+	err = c.UnmarshalText([]byte("3.4M"))
+	AssertCallResult(t, "Count.UnmarshalText(%#v)", []any{"3.4M"}, []any{Count(3.4e6), nil}, []any{c, err})
+}
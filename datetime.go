@@ -0,0 +1,164 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	"time"
+)
+
+// Time is a time.Time printed relative to now by String and Format.
+type Time time.Time
+
+// ClockedTime is a Time printed relative to Clock's now instead of the SystemClock's.
+type ClockedTime struct {
+	Time
+	Clock Clock
+}
+
+func (ct ClockedTime) String() string {
+	return ct.Time.relative(ct.Clock, NewRelativeFormatter())
+}
+
+func (ct ClockedTime) Format(f fmt.State, c rune) {
+	ct.Time.format(f, c, ct.Clock)
+}
+
+// RelativeTo returns t printed relative to clock's now.
+func (t Time) RelativeTo(clock Clock) ClockedTime {
+	return ClockedTime{t, clock}
+}
+
+// String prints t relative to now like "3 hours ago", see RelativeFormatter.
+func (t Time) String() string {
+	return t.relative(SystemClock, NewRelativeFormatter())
+}
+
+// Format supports these verbs:
+//
+//	%s, %v  relative like String, the precision is the amount of additional segments
+//	%a      approximately relative, e.g. "about 3 hours ago"
+//	%c      calendar-style like Calendar
+//	%d      absolute as RFC 3339, with nanoseconds if the # flag is given
+//	%#v     Go syntax
+func (t Time) Format(f fmt.State, c rune) {
+	t.format(f, c, SystemClock)
+}
+
+func (t Time) format(f fmt.State, c rune, clock Clock) {
+	switch c {
+	case 'v':
+		if f.Flag('#') {
+			writeText(f, t.GoString())
+			return
+		}
+
+		fallthrough
+	case 's':
+		prec, _ := f.Precision()
+		rf := NewRelativeFormatter()
+
+		writeText(f, t.relative(clock, rf.WithFormatter(rf.formatter.WithMaxSegments(prec+1))))
+	case 'a':
+		rf := NewRelativeFormatter()
+		writeText(f, t.relative(clock, rf.WithFormatter(rf.formatter.WithStyle(Approximate))))
+	case 'c':
+		writeText(f, t.Calendar(clock))
+	case 'd':
+		layout := time.RFC3339
+		if f.Flag('#') {
+			layout = time.RFC3339Nano
+		}
+
+		writeText(f, time.Time(t).Format(layout))
+	default:
+		fmt.Fprintf(f, "%%!%c(go_pretty_print.Time=%s)", c, time.Time(t))
+	}
+}
+
+func (t Time) relative(clock Clock, rf RelativeFormatter) string {
+	return rf.WithClock(clock).Format(time.Time(t))
+}
+
+// Calendar prints t relative to clock's now (or the SystemClock's if nil) in days,
+// e.g. "yesterday at 15:04", "last Monday at 15:04" or "Friday at 15:04" within a week
+// and "2006-01-02" otherwise. Unlike String, it's English-only.
+func (t Time) Calendar(clock Clock) string {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	tt := time.Time(t)
+	at := " at " + tt.Format("15:04")
+
+	switch days := daysBetween(clock.Now().In(tt.Location()), tt); {
+	case days == 0:
+		return "today" + at
+	case days == -1:
+		return "yesterday" + at
+	case days == 1:
+		return "tomorrow" + at
+	case days < 0 && days > -7:
+		return "last " + tt.Weekday().String() + at
+	case days > 0 && days < 7:
+		return tt.Weekday().String() + at
+	default:
+		return tt.Format("2006-01-02")
+	}
+}
+
+// daysBetween returns the amount of calendar days from a to b, regardless of DST.
+func daysBetween(a, b time.Time) int {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+
+	return int(time.Date(by, bm, bd, 0, 0, 0, 0, time.UTC).Sub(time.Date(ay, am, ad, 0, 0, 0, 0, time.UTC)) / (24 * time.Hour))
+}
+
+// GoString prints t as Go expression, e.g. "go_pretty_print.Time(time.Date(2006, time.January, 2, 15, 4, 5, 0, time.UTC))".
+func (t Time) GoString() string {
+	tt := time.Time(t)
+	loc := "time.Local"
+
+	switch tt.Location() {
+	case time.UTC:
+		loc = "time.UTC"
+	case time.Local:
+	default:
+		name, offset := tt.Zone()
+		loc = fmt.Sprintf("time.FixedZone(%q, %d)", name, offset)
+	}
+
+	return fmt.Sprintf(
+		"go_pretty_print.Time(time.Date(%d, time.%s, %d, %d, %d, %d, %d, %s))",
+		tt.Year(), tt.Month(), tt.Day(), tt.Hour(), tt.Minute(), tt.Second(), tt.Nanosecond(), loc,
+	)
+}
+
+// MarshalJSON writes an RFC 3339 string like time.Time.
+func (t Time) MarshalJSON() ([]byte, error) {
+	return time.Time(t).MarshalJSON()
+}
+
+// UnmarshalJSON accepts RFC 3339 strings (as written by MarshalJSON) and numbers of seconds since the Unix epoch.
+func (t *Time) UnmarshalJSON(data []byte) error {
+	return t.unmarshalJSON(data, Duration(time.Second))
+}
+
+// unmarshalJSON is UnmarshalJSON with numbers in the given unit.
+func (t *Time) unmarshalJSON(data []byte, unit Duration) error {
+	return unmarshalJSONValue(data, t, func(s string) (Time, error) {
+		return parseUnixTime(s, unit)
+	}, func(s string) (Time, error) {
+		var tt Time
+		err := tt.UnmarshalText([]byte(s))
+		return tt, err
+	})
+}
+
+// MarshalText writes an RFC 3339 string like time.Time.
+func (t Time) MarshalText() ([]byte, error) {
+	return time.Time(t).MarshalText()
+}
+
+func (t *Time) UnmarshalText(text []byte) error {
+	return (*time.Time)(t).UnmarshalText(text)
+}
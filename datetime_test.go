@@ -0,0 +1,151 @@
+package go_pretty_print
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestTime_String(t *testing.T) {
+	AssertCallResult(
+		t, "Time(time.Now().Add(%v)).String()", []any{-h3}, []any{"3 hours ago"},
+		[]any{Time(time.Now().Add(-h3)).String()},
+	)
+
+	AssertCallResult(
+		t, "Time(now + %v).RelativeTo(fixedClock()).String()", []any{d2 + h3}, []any{"in 2 days"},
+		[]any{Time(relativeNow.Add(d2 + h3)).RelativeTo(fixedClock()).String()},
+	)
+}
+
+func TestTime_Format(t *testing.T) {
+	assertTime_Format(t, relativeNow.Add(-d2-h3), "%v", "2 days ago")
+	assertTime_Format(t, relativeNow.Add(-d2-h3), "%s", "2 days ago")
+	assertTime_Format(t, relativeNow.Add(-d2-h3-m4), "%.1s", "2 days 3 hours ago")
+	assertTime_Format(t, relativeNow.Add(-d2-h3), "%14s|", "    2 days ago|")
+	assertTime_Format(t, relativeNow.Add(-d2-h3), "%-14s|", "2 days ago    |")
+	assertTime_Format(t, relativeNow.Add(d2+h3), "%+s", "in 2 days")
+	assertTime_Format(t, relativeNow.Add(d2+h3), "% s", "in 2 days")
+	assertTime_Format(t, relativeNow.Add(-d2-h3), "%+014s|", "    2 days ago|")
+	assertTime_Format(t, relativeNow, "%v", "just now")
+	assertTime_Format(t, relativeNow.Add(d2-h3), "%a", "in almost 2 days")
+	assertTime_Format(t, relativeNow.Add(-m4), "%c", "today at 20:16")
+	assertTime_Format(t, relativeNow.Add(d2/2), "%c", "tomorrow at 20:20")
+	assertTime_Format(t, relativeNow.Add(-21*time.Hour), "%c", "yesterday at 23:20")
+	assertTime_Format(t, relativeNow.Add(-d2), "%c", "last Tuesday at 20:20")
+	assertTime_Format(t, relativeNow.Add(d2+h3+m4), "%c", "Saturday at 23:24")
+	assertTime_Format(t, relativeNow.Add(-w1), "%c", "2020-02-13")
+	assertTime_Format(t, relativeNow.Add(w1), "%c", "2020-02-27")
+	assertTime_Format(t, relativeNow.Add(ms6), "%d", "2020-02-20T20:20:20Z")
+	assertTime_Format(t, relativeNow.Add(ms6), "%#d", "2020-02-20T20:20:20.006Z")
+	assertTime_Format(t, relativeNow, "%q", "%!q(go_pretty_print.Time=2020-02-20 20:20:20 +0000 UTC)")
+
+	assertTime_Format(
+		t, relativeNow.Add(ns8), "%#v",
+		"go_pretty_print.Time(time.Date(2020, time.February, 20, 20, 20, 20, 8, time.UTC))",
+	)
+
+	assertTime_Format(
+		t, relativeNow.In(time.FixedZone("CET", 3600)), "%#v",
+		`go_pretty_print.Time(time.Date(2020, time.February, 20, 21, 20, 20, 0, time.FixedZone("CET", 3600)))`,
+	)
+}
+
+func assertTime_Format(t *testing.T, tm time.Time, format, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "fmt.Sprintf(%#v, Time(%v).RelativeTo(fixedClock()))", []any{format, tm}, []any{expected},
+		[]any{fmt.Sprintf(format, Time(tm).RelativeTo(fixedClock()))},
+	)
+}
+
+func TestTime_Calendar(t *testing.T) {
+	cet := time.FixedZone("CET", 3600)
+
+	AssertCallResult(
+		t, "Time(%v).Calendar(fixedClock())", []any{relativeNow.Add(h3)}, []any{"tomorrow at 00:20"},
+		[]any{Time(relativeNow.Add(h3).In(cet)).Calendar(fixedClock())},
+	)
+
+	AssertCallResult(
+		t, "Time(%v).Calendar(nil)", []any{relativeNow}, []any{"2020-02-20"},
+		[]any{Time(relativeNow).Calendar(nil)},
+	)
+}
+
+func TestTime_JSON(t *testing.T) {
+	type event struct {
+		At      Time          `json:"at"`
+		Unix    UnixTime      `json:"unix"`
+		UnixMil UnixMilliTime `json:"unix_ms"`
+	}
+
+	at := relativeNow.Add(500 * time.Millisecond)
+	jsn, err := json.Marshal(event{Time(at), UnixTime(at), UnixMilliTime(at)})
+
+	AssertCallResult(
+		t, "json.Marshal(event(%v))", []any{at},
+		[]any{`{"at":"2020-02-20T20:20:20.5Z","unix":1582230020.5,"unix_ms":1582230020500}`, nil}, []any{string(jsn), err},
+	)
+
+	var e event
+	err = json.Unmarshal(jsn, &e)
+
+	AssertCallResult(
+		t, "json.Unmarshal(%#v)", []any{string(jsn)}, []any{true, true, true, nil},
+		[]any{time.Time(e.At).Equal(at), time.Time(e.Unix).Equal(at), time.Time(e.UnixMil).Equal(at), err},
+	)
+
+	err = json.Unmarshal([]byte(`{"at":1582230020.5,"unix":"2020-02-20T20:20:20.5Z","unix_ms":null}`), &e)
+
+	AssertCallResult(
+		t, "json.Unmarshal(...)", nil, []any{true, true, true, nil},
+		[]any{time.Time(e.At).Equal(at), time.Time(e.Unix).Equal(at), time.Time(e.UnixMil).Equal(at), err},
+	)
+
+	for _, c := range [...]struct {
+		at   time.Time
+		unix string
+	}{
+		{time.Unix(0, 0), "0"},
+		{time.Unix(-1, 500000000), "-0.5"},
+		{time.Unix(-2, 999999999), "-1.000000001"},
+		{time.Unix(-2, 0), "-2"},
+		{time.Date(2300, time.January, 1, 0, 0, 0, 0, time.UTC), "10413792000"},
+		{time.Date(1600, time.January, 1, 0, 0, 0, 1, time.UTC), "-11676095999.999999999"},
+		{time.Unix(math.MaxInt64-unixToInternal, 999999999), "9223371974719179007.999999999"},
+	} {
+		jsn, err := UnixTime(c.at).MarshalJSON()
+		AssertCallResult(t, "UnixTime(%v).MarshalJSON()", []any{c.at}, []any{c.unix, nil}, []any{string(jsn), err})
+
+		var ut UnixTime
+		err = ut.UnmarshalJSON(jsn)
+		AssertCallResult(t, "UnixTime.UnmarshalJSON(%#v)", []any{c.unix}, []any{true, nil}, []any{time.Time(ut).Equal(c.at), err})
+	}
+
+	jsn, err = UnixMilliTime(time.Unix(-1, 999999)).MarshalJSON()
+	AssertCallResult(t, "UnixMilliTime(%v).MarshalJSON()", []any{time.Unix(-1, 999999)}, []any{"-1000", nil}, []any{string(jsn), err})
+
+	at = time.Date(2300, time.January, 1, 0, 0, 0, int(500*time.Millisecond), time.UTC)
+	jsn, err = json.Marshal(event{Time(at), UnixTime(at), UnixMilliTime(at)})
+	AssertCallResult(t, "json.Marshal(event(%v))", []any{at}, []any{true, nil}, []any{err == nil, json.Unmarshal(jsn, &e)})
+
+	AssertCallResult(
+		t, "json.Unmarshal(%#v)", []any{string(jsn)}, []any{true, true, true},
+		[]any{time.Time(e.At).Equal(at), time.Time(e.Unix).Equal(at), time.Time(e.UnixMil).Equal(at)},
+	)
+
+	for _, unix := range [...]string{"9223372036854775808", "-9223372036854775809", "1e999999999"} {
+		var ut UnixTime
+		err := ut.UnmarshalJSON([]byte(unix))
+		AssertCallResult(t, "UnixTime.UnmarshalJSON(%#v)", []any{unix}, []any{&ParseError{unix, 0, "time out of range"}}, []any{err})
+	}
+
+	var tm Time
+	_, isTypeError := tm.UnmarshalJSON([]byte("true")).(*json.UnmarshalTypeError)
+	AssertCallResult(t, "Time.UnmarshalJSON(%#v)", []any{"true"}, []any{true}, []any{isTypeError})
+}
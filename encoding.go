@@ -0,0 +1,199 @@
+package go_pretty_print
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NanosDuration is a Duration marshaled as JSON integer of nanoseconds, e.g. 1500000000.
+type NanosDuration Duration
+
+func (nd NanosDuration) String() string {
+	return Duration(nd).String()
+}
+
+func (nd NanosDuration) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(nd), 10), nil
+}
+
+// UnmarshalJSON accepts numbers of nanoseconds and strings like Duration.UnmarshalJSON.
+func (nd *NanosDuration) UnmarshalJSON(data []byte) error {
+	return (*Duration)(nd).unmarshalJSON(data, Duration(time.Nanosecond))
+}
+
+// MillisDuration is a Duration marshaled as JSON integer of milliseconds, e.g. 1500.
+// Like time.Duration.Milliseconds, MarshalJSON truncates sub-millisecond parts.
+type MillisDuration Duration
+
+func (md MillisDuration) String() string {
+	return Duration(md).String()
+}
+
+func (md MillisDuration) MarshalJSON() ([]byte, error) {
+	return strconv.AppendInt(nil, int64(md)/int64(time.Millisecond), 10), nil
+}
+
+// UnmarshalJSON accepts numbers of milliseconds and strings like Duration.UnmarshalJSON.
+func (md *MillisDuration) UnmarshalJSON(data []byte) error {
+	return (*Duration)(md).unmarshalJSON(data, Duration(time.Millisecond))
+}
+
+// TextDuration is a Duration marshaled as JSON string of all units, e.g. "1h 2m".
+type TextDuration Duration
+
+func (td TextDuration) String() string {
+	return Duration(td).String()
+}
+
+func (td TextDuration) MarshalText() ([]byte, error) {
+	return Duration(td).MarshalText()
+}
+
+func (td *TextDuration) UnmarshalText(text []byte) error {
+	return (*Duration)(td).UnmarshalText(text)
+}
+
+func (td TextDuration) MarshalJSON() ([]byte, error) {
+	text, _ := Duration(td).MarshalText()
+	return json.Marshal(string(text))
+}
+
+func (td *TextDuration) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, td)
+}
+
+// GoDuration is a Duration marshaled as JSON string in the time.Duration syntax, e.g. "1h2m0s".
+type GoDuration Duration
+
+func (gd GoDuration) String() string {
+	return time.Duration(gd).String()
+}
+
+func (gd GoDuration) MarshalText() ([]byte, error) {
+	return []byte(time.Duration(gd).String()), nil
+}
+
+// UnmarshalText accepts the time.Duration and the Duration.String syntax.
+func (gd *GoDuration) UnmarshalText(text []byte) error {
+	return (*Duration)(gd).UnmarshalText(text)
+}
+
+func (gd GoDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(gd).String())
+}
+
+func (gd *GoDuration) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, gd)
+}
+
+// unmarshalJSONValue unmarshals a JSON number via number or a JSON string via str (or null, as no-op) into v.
+// A nil func rejects the respective JSON type like any other one.
+func unmarshalJSONValue[T interface{}](data []byte, v *T, number, str func(string) (T, error)) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var raw interface{}
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	if err := decoder.Decode(&raw); err != nil {
+		return err
+	}
+
+	var parse func(string) (T, error)
+	var s string
+
+	switch raw := raw.(type) {
+	case json.Number:
+		parse, s = number, string(raw)
+	case string:
+		parse, s = str, raw
+	}
+
+	if parse == nil {
+		return &json.UnmarshalTypeError{Value: string(data), Type: reflect.TypeOf(v).Elem()}
+	}
+
+	t, err := parse(s)
+	if err == nil {
+		*v = t
+	}
+
+	return err
+}
+
+// unmarshalJSONString unmarshals a JSON string (or null, as no-op) via tu.
+func unmarshalJSONString(data []byte, tu encoding.TextUnmarshaler) error {
+	if string(data) == "null" {
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	return tu.UnmarshalText([]byte(s))
+}
+
+// UnixTime is a Time marshaled as JSON number of seconds since the Unix epoch, e.g. 1136214245.5.
+type UnixTime Time
+
+func (ut UnixTime) String() string {
+	return Time(ut).String()
+}
+
+func (ut UnixTime) MarshalJSON() ([]byte, error) {
+	tt := time.Time(ut)
+	sec, nsec := tt.Unix(), uint64(tt.Nanosecond())
+	var jsn []byte
+
+	if sec < 0 && nsec > 0 {
+		sec++
+		nsec = uint64(time.Second) - nsec
+
+		if sec == 0 {
+			jsn = append(jsn, '-')
+		}
+	}
+
+	jsn = strconv.AppendInt(jsn, sec, 10)
+
+	if nsec > 0 {
+		fraction := strconv.FormatUint(nsec+uint64(time.Second), 10)
+
+		jsn = append(jsn, '.')
+		jsn = append(jsn, strings.TrimRight(fraction[1:], "0")...)
+	}
+
+	return jsn, nil
+}
+
+// UnmarshalJSON accepts numbers of seconds and strings like Time.UnmarshalJSON.
+func (ut *UnixTime) UnmarshalJSON(data []byte) error {
+	return (*Time)(ut).unmarshalJSON(data, Duration(time.Second))
+}
+
+// UnixMilliTime is a Time marshaled as JSON integer of milliseconds since the Unix epoch, e.g. 1136214245500.
+// MarshalJSON drops sub-millisecond parts, i.e. rounds towards the past.
+type UnixMilliTime Time
+
+func (umt UnixMilliTime) String() string {
+	return Time(umt).String()
+}
+
+func (umt UnixMilliTime) MarshalJSON() ([]byte, error) {
+	tt := time.Time(umt)
+	return strconv.AppendInt(nil, tt.Unix()*1000+int64(tt.Nanosecond())/int64(time.Millisecond), 10), nil
+}
+
+// UnmarshalJSON accepts numbers of milliseconds and strings like Time.UnmarshalJSON.
+func (umt *UnixMilliTime) UnmarshalJSON(data []byte) error {
+	return (*Time)(umt).unmarshalJSON(data, Duration(time.Millisecond))
+}
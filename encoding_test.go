@@ -0,0 +1,89 @@
+package go_pretty_print
+
+import (
+	"encoding/json"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+type encodings struct {
+	Nanos  NanosDuration  `json:"nanos"`
+	Millis MillisDuration `json:"millis"`
+	Text   TextDuration   `json:"text"`
+	Go     GoDuration     `json:"go"`
+	ISO    ISODuration    `json:"iso"`
+}
+
+func newEncodings(d time.Duration) encodings {
+	return encodings{NanosDuration(d), MillisDuration(d), TextDuration(d), GoDuration(d), ISODuration(d)}
+}
+
+func TestEncodings_MarshalJSON(t *testing.T) {
+	assertEncodings_MarshalJSON(t, 0, `{"nanos":0,"millis":0,"text":"0s","go":"0s","iso":"PT0S"}`)
+
+	assertEncodings_MarshalJSON(
+		t, h3+m4+ms6+us7,
+		`{"nanos":11040006007000,"millis":11040006,"text":"3h 4m 6ms 7us","go":"3h4m0.006007s","iso":"PT3H4M0.006007S"}`,
+	)
+
+	assertEncodings_MarshalJSON(
+		t, -s5-ms6,
+		`{"nanos":-5006000000,"millis":-5006,"text":"-5s 6ms","go":"-5.006s","iso":"-PT5.006S"}`,
+	)
+
+	assertEncodings_MarshalJSON(
+		t, math.MinInt64,
+		`{"nanos":-9223372036854775808,"millis":-9223372036854,"text":"-15250w 1d 23h 47m 16s 854ms 775us 808ns",`+
+			`"go":"-2562047h47m16.854775808s","iso":"-P106751DT23H47M16.854775808S"}`,
+	)
+}
+
+func assertEncodings_MarshalJSON(t *testing.T, d time.Duration, expected string) {
+	t.Helper()
+
+	jsn, err := json.Marshal(newEncodings(d))
+	AssertCallResult(t, "json.Marshal(newEncodings(%v))", []any{d}, []any{expected, nil}, []any{string(jsn), err})
+}
+
+func TestEncodings_UnmarshalJSON(t *testing.T) {
+	for _, d := range [...]time.Duration{0, ms6, h3 + m4 + ms6, -w1 - d2 - ns8, math.MaxInt64, math.MinInt64} {
+		expected := newEncodings(d)
+		expected.Millis = MillisDuration(d / time.Millisecond * time.Millisecond)
+
+		jsn, _ := json.Marshal(newEncodings(d))
+		assertEncodings_UnmarshalJSON(t, string(jsn), expected, nil)
+	}
+
+	assertEncodings_UnmarshalJSON(
+		t, `{"nanos":"1s","millis":1.5,"text":"1h30m","go":"1h 30m","iso":null}`,
+		encodings{NanosDuration(time.Second), MillisDuration(1500 * time.Microsecond), TextDuration(90 * time.Minute), GoDuration(90 * time.Minute), 0},
+		nil,
+	)
+
+	var e encodings
+	_, isTypeError := json.Unmarshal([]byte(`{"text":1}`), &e).(*json.UnmarshalTypeError)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"text":1}`}, []any{true}, []any{isTypeError})
+
+	assertEncodings_UnmarshalJSON(t, `{"go":"1x"}`, encodings{}, &ParseError{"1x", 1, "unknown unit"})
+	assertEncodings_UnmarshalJSON(t, `{"nanos":"1x"}`, encodings{}, &ParseError{"1x", 1, "unknown unit"})
+}
+
+func assertEncodings_UnmarshalJSON(t *testing.T, jsn string, expected encodings, expectedErr error) {
+	t.Helper()
+
+	var actual encodings
+	err := json.Unmarshal([]byte(jsn), &actual)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{jsn}, []any{expected, expectedErr}, []any{actual, err})
+}
+
+func TestEncodings_String(t *testing.T) {
+	e := newEncodings(h3 + m4 + s5)
+
+	AssertCallResult(
+		t, "newEncodings(%v).*.String()", []any{h3 + m4 + s5},
+		[]any{"3h 4m", "3h 4m", "3h 4m", "3h4m5s", "PT3H4M5S"},
+		[]any{e.Nanos.String(), e.Millis.String(), e.Text.String(), e.Go.String(), e.ISO.String()},
+	)
+}
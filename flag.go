@@ -0,0 +1,32 @@
+package go_pretty_print
+
+import "flag"
+
+var _ flag.Value = (*Duration)(nil)
+
+// Set implements flag.Value just like UnmarshalText.
+func (dur *Duration) Set(s string) error {
+	return dur.UnmarshalText([]byte(s))
+}
+
+// Type implements pflag.Value.
+func (*Duration) Type() string {
+	return "duration"
+}
+
+// DurationVar defines a Duration flag in fs (or flag.CommandLine if nil) which is stored in p.
+func DurationVar(fs *flag.FlagSet, p *Duration, name string, value Duration, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+
+	*p = value
+	fs.Var(p, name, usage)
+}
+
+// DurationFlag defines a Duration flag in fs (or flag.CommandLine if nil) and returns its storage.
+func DurationFlag(fs *flag.FlagSet, name string, value Duration, usage string) *Duration {
+	p := new(Duration)
+	DurationVar(fs, p, name, value, usage)
+	return p
+}
@@ -0,0 +1,67 @@
+package go_pretty_print
+
+import (
+	"bytes"
+	"flag"
+	. "github.com/Al2Klimov/go-test-utils"
+	"testing"
+	"time"
+)
+
+func TestDuration_Set(t *testing.T) {
+	assertDuration_Set(t, "1w 2d", w1+d2, nil)
+	assertDuration_Set(t, "-7us 8ns", -us7-ns8, nil)
+	assertDuration_Set(t, "1m30s", 90*time.Second, nil)
+	assertDuration_Set(t, "1x", 42, &ParseError{"1x", 1, "unknown unit"})
+}
+
+func assertDuration_Set(t *testing.T, s string, expected time.Duration, expectedErr error) {
+	t.Helper()
+
+	d := Duration(42)
+	err := d.Set(s)
+	AssertCallResult(t, "Duration.Set(%#v)", []any{s}, []any{Duration(expected), expectedErr}, []any{d, err})
+}
+
+func TestDurationFlag(t *testing.T) {
+	assertDurationFlag(t, nil, w1+d2, nil)
+	assertDurationFlag(t, []string{"-timeout", "1w 2d 3h"}, w1+d2+h3, nil)
+	assertDurationFlag(t, []string{"-timeout=-5s"}, -s5, nil)
+	assertDurationFlag(t, []string{"--timeout", "90m"}, h3/2, nil)
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&bytes.Buffer{})
+	DurationFlag(fs, "timeout", 0, "")
+	err := fs.Parse([]string{"-timeout", "soon"})
+	AssertCallResult(
+		t, "FlagSet.Parse(%#v)", []any{[]string{"-timeout", "soon"}},
+		[]any{`invalid value "soon" for flag -timeout: go_pretty_print: expected a number at offset 0 of "soon"`},
+		[]any{err.Error()},
+	)
+}
+
+func assertDurationFlag(t *testing.T, args []string, expected time.Duration, expectedErr error) {
+	t.Helper()
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	d := DurationFlag(fs, "timeout", Duration(w1+d2), "")
+	err := fs.Parse(args)
+	AssertCallResult(t, "FlagSet.Parse(%#v)", []any{args}, []any{Duration(expected), expectedErr}, []any{*d, err})
+}
+
+func TestDurationVar_PrintDefaults(t *testing.T) {
+	var d Duration
+	var buf bytes.Buffer
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(&buf)
+	DurationVar(fs, &d, "timeout", Duration(w1+d2), "how long to `wait`")
+	DurationVar(fs, &d, "delay", 0, "initial delay")
+	fs.PrintDefaults()
+
+	AssertCallResult(
+		t, "FlagSet.PrintDefaults()", nil,
+		[]any{"  -delay value\n    \tinitial delay\n  -timeout wait\n    \thow long to wait (default 1w 2d)\n"},
+		[]any{buf.String()},
+	)
+}
@@ -0,0 +1,150 @@
+package go_pretty_print
+
+import (
+	"time"
+)
+
+// DurationUnit is something a Duration can be broken down into.
+type DurationUnit = Unit[Duration]
+
+var (
+	// UnitYear is an average Gregorian year.
+	UnitYear = DurationUnit{Symbol: "y", One: Duration(31556952 * time.Second)}
+	// UnitMonth is an average Gregorian month.
+	UnitMonth       = DurationUnit{Symbol: "mo", One: Duration(2629746 * time.Second)}
+	UnitWeek        = DurationUnit{Symbol: "w", One: Duration(7 * 24 * time.Hour)}
+	UnitDay         = DurationUnit{Symbol: "d", One: Duration(24 * time.Hour)}
+	UnitHour        = DurationUnit{Symbol: "h", One: Duration(time.Hour)}
+	UnitMinute      = DurationUnit{Symbol: "m", One: Duration(time.Minute)}
+	UnitSecond      = DurationUnit{Symbol: "s", One: Duration(time.Second)}
+	UnitMillisecond = DurationUnit{Symbol: "ms", One: Duration(time.Millisecond)}
+	UnitMicrosecond = DurationUnit{Symbol: "us", One: Duration(time.Microsecond)}
+	UnitNanosecond  = DurationUnit{Symbol: "ns", One: Duration(time.Nanosecond)}
+)
+
+// DefaultUnits returns the units Duration.String uses.
+func DefaultUnits() []DurationUnit {
+	return append([]DurationUnit(nil), durationUnits[:]...)
+}
+
+// Formatter prints Durations as segments of units like "1w 2d".
+// It's immutable and safe for concurrent use.
+// The zero value prints all segments, like Duration.MarshalText.
+type Formatter struct {
+	// system is durationSystem if nil.
+	system      *UnitSystem[Duration]
+	maxSegments int
+	list        *ListPattern
+	style       Style
+	rounding    Rounding
+	locale      *Locale
+	// approximation is used by the Approximate Style, the default one if nil.
+	approximation *Approximation
+	// relative tells to prefer Locale.Relative.Units.
+	relative bool
+}
+
+// NewFormatter returns the Formatter Duration.String uses.
+func NewFormatter() Formatter {
+	return Formatter{maxSegments: 2}
+}
+
+// WithUnits returns a copy of f using the given units (or the default ones if none), largest first.
+// Panics if any of them isn't positive.
+func (f Formatter) WithUnits(units ...DurationUnit) Formatter {
+	if len(units) == 0 {
+		f.system = nil
+		return f
+	}
+
+	system := NewUnitSystem(units...)
+	f.system = &system
+
+	// Prefer "0s" over e.g. "0ns".
+	for _, unit := range system.units {
+		if unit.One == UnitSecond.One {
+			system.zero = unit
+			break
+		}
+	}
+
+	return f
+}
+
+// WithMaxSegments returns a copy of f printing at most n non-zero segments, or all if n < 1.
+func (f Formatter) WithMaxSegments(n int) Formatter {
+	f.maxSegments = n
+	return f
+}
+
+// WithSeparator returns a copy of f joining segments with sep instead of as the Locale does.
+func (f Formatter) WithSeparator(sep string) Formatter {
+	f.list = &ListPattern{sep, sep}
+	return f
+}
+
+func (f Formatter) WithStyle(style Style) Formatter {
+	f.style = style
+	return f
+}
+
+func (f Formatter) WithRounding(rounding Rounding) Formatter {
+	f.rounding = rounding
+	return f
+}
+
+// WithApproximation returns a copy of f using the given thresholds for the Approximate Style.
+func (f Formatter) WithApproximation(a Approximation) Formatter {
+	f.approximation = &a
+	return f
+}
+
+// WithLocale returns a copy of f using the given Locale, or English if nil.
+func (f Formatter) WithLocale(locale *Locale) Formatter {
+	f.locale = locale
+	return f
+}
+
+// Format prints d as configured.
+func (f Formatter) Format(d Duration) string {
+	var buf [64]byte
+	return string(f.AppendFormat(buf[:0], d))
+}
+
+// AppendFormat is like Format, but appends to dst without allocating (unless dst is too small).
+func (f Formatter) AppendFormat(dst []byte, d Duration) []byte {
+	system := f.system
+	if system == nil {
+		system = &durationSystem
+	}
+
+	locale := f.locale
+	if locale == nil {
+		locale = localeEnglish
+	}
+
+	if f.style == Approximate {
+		return f.appendApproximate(dst, d, system.units, locale)
+	}
+
+	list := locale.ShortList
+	switch {
+	case f.list != nil:
+		list = *f.list
+	case f.style == Long:
+		list = locale.LongList
+	}
+
+	negative, abs := d.abs()
+	abs = system.round(abs, negative, f.maxSegments, f.rounding)
+
+	return system.appendSegments(dst, negative, abs, f.maxSegments, func(dst []byte, i, n int) []byte {
+		if i == n-1 {
+			return append(dst, list.Last...)
+		}
+
+		return append(dst, list.Separator...)
+	}, func(dst []byte, unit DurationUnit, amount uint64) []byte {
+		return locale.appendUnit(dst, unit, amount, f.style, f.relative)
+	})
+}
@@ -0,0 +1,114 @@
+package go_pretty_print
+
+import (
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFormatter_Format(t *testing.T) {
+	all := w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8
+
+	assertFormatter_Format(t, NewFormatter(), all, "1w 2d")
+	assertFormatter_Format(t, Formatter{}, all, "1w 2d 3h 4m 5s 6ms 7us 8ns")
+	assertFormatter_Format(t, Formatter{}, 0, "0s")
+	assertFormatter_Format(t, NewFormatter().WithMaxSegments(3), all, "1w 2d 3h")
+	assertFormatter_Format(t, NewFormatter().WithMaxSegments(0), -all, "-1w 2d 3h 4m 5s 6ms 7us 8ns")
+	assertFormatter_Format(t, NewFormatter().WithMaxSegments(-1), math.MinInt64, "-15250w 1d 23h 47m 16s 854ms 775us 808ns")
+
+	noWeeks := NewFormatter().WithUnits(UnitDay, UnitHour, UnitMinute, UnitSecond)
+	assertFormatter_Format(t, noWeeks, all, "9d 3h")
+	assertFormatter_Format(t, noWeeks.WithMaxSegments(0), all, "9d 3h 4m 5s")
+	assertFormatter_Format(t, noWeeks.WithMaxSegments(0), -all, "-9d 3h 4m 5s")
+	assertFormatter_Format(t, noWeeks, ms6, "0s")
+	assertFormatter_Format(t, noWeeks, 0, "0s")
+	assertFormatter_Format(t, noWeeks.WithRounding(HalfUp), 500*time.Millisecond, "1s")
+	assertFormatter_Format(t, noWeeks.WithRounding(HalfUp), 499*time.Millisecond, "0s")
+	assertFormatter_Format(t, noWeeks.WithRounding(Ceiling).WithMaxSegments(0), all, "9d 3h 4m 6s")
+
+	toMS := NewFormatter().WithUnits(UnitHour, UnitMinute, UnitSecond, UnitMillisecond).WithMaxSegments(0)
+	assertFormatter_Format(t, toMS, all, "219h 4m 5s 6ms")
+	assertFormatter_Format(t, toMS, us7, "0s")
+	assertFormatter_Format(t, toMS.WithUnits(UnitMillisecond, UnitMinute), h3+ms6+us7, "180m 6ms")
+
+	calendar := NewFormatter().WithUnits(UnitYear, UnitMonth, UnitDay, UnitHour)
+	assertFormatter_Format(t, calendar, 400*d2/2, "1y 1mo")
+	assertFormatter_Format(t, calendar.WithMaxSegments(0), 400*d2/2, "1y 1mo 4d 7h")
+	assertFormatter_Format(t, calendar.WithStyle(Long), 400*d2/2, "1 year 1 month")
+	assertFormatter_Format(t, calendar.WithLocale(mustLookupLocale("de")).WithStyle(Long), 800*d2/2, "2 Jahre und 2 Monate")
+	assertFormatter_Format(t, calendar.WithLocale(mustLookupLocale("ru")), 800*d2/2, "2 г. 2 мес.")
+
+	assertFormatter_Format(t, NewFormatter().WithSeparator(", "), all, "1w, 2d")
+	assertFormatter_Format(t, NewFormatter().WithSeparator("").WithMaxSegments(3), all, "1w2d3h")
+	assertFormatter_Format(
+		t, NewFormatter().WithSeparator(" + ").WithStyle(Long).WithLocale(mustLookupLocale("de")),
+		all, "1 Woche + 2 Tage",
+	)
+
+	fortnight := DurationUnit{"fn", Duration(2 * w1), UnitNames{
+		same("{0}fn"), oneOther("{0} fortnight", "{0} fortnights"),
+	}}
+	custom := NewFormatter().WithUnits(UnitDay, fortnight)
+	assertFormatter_Format(t, custom, 3*w1+d2, "1fn 9d")
+	assertFormatter_Format(t, custom.WithStyle(Long), 2*w1+d2/2, "1 fortnight 1 day")
+	assertFormatter_Format(t, custom.WithLocale(mustLookupLocale("de")).WithStyle(Long), 4*w1, "2 fortnights")
+	assertFormatter_Format(t, NewFormatter().WithUnits(DurationUnit{Symbol: "x", One: 3}), 7, "2x")
+	assertFormatter_Format(t, NewFormatter().WithUnits(), all, "1w 2d")
+}
+
+func assertFormatter_Format(t *testing.T, f Formatter, d time.Duration, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Formatter.Format(Duration(%v))", []any{d}, []any{expected}, []any{f.Format(Duration(d))})
+}
+
+func TestFormatter_WithUnits(t *testing.T) {
+	defer func() {
+		AssertCallResult(
+			t, "NewFormatter().WithUnits(%#v)", []any{UnitDay}, []any{"go_pretty_print: unit x must be positive"},
+			[]any{recover()},
+		)
+	}()
+
+	NewFormatter().WithUnits(UnitDay, DurationUnit{Symbol: "x"})
+}
+
+func TestFormatter_Concurrency(t *testing.T) {
+	f := NewFormatter().WithUnits(UnitDay, UnitHour).WithStyle(Long)
+	var wg sync.WaitGroup
+
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+
+		go func(i int) {
+			defer wg.Done()
+
+			g := f.WithMaxSegments(i).WithUnits(UnitMinute)
+			_ = g.Format(Duration(i) * Duration(time.Minute))
+			_ = f.Format(Duration(i) * Duration(time.Hour))
+		}(i)
+	}
+
+	wg.Wait()
+
+	assertFormatter_Format(t, f, d2+h3, "2 days 3 hours")
+}
+
+func TestDefaultUnits(t *testing.T) {
+	units := DefaultUnits()
+	units[0] = UnitYear
+
+	AssertCallResult(t, "DefaultUnits()[0]", nil, []any{UnitWeek}, []any{DefaultUnits()[0]})
+	assertDuration_String(t, w1, "1w")
+}
+
+func mustLookupLocale(tag string) *Locale {
+	l, ok := LookupLocale(tag)
+	if !ok {
+		panic("no such locale: " + tag)
+	}
+
+	return l
+}
@@ -0,0 +1,230 @@
+package go_pretty_print
+
+import (
+	"encoding/json"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ISO8601 prints dur as an ISO 8601 duration like "P1DT2H3M4.5S".
+// Days are 24 hours long and negative durations are prefixed with "-" as of ISO 8601-2.
+func (dur Duration) ISO8601() string {
+	if dur == 0 {
+		return "PT0S"
+	}
+
+	negative, abs := dur.abs()
+	var sb strings.Builder
+
+	if negative {
+		sb.WriteByte('-')
+	}
+
+	sb.WriteByte('P')
+
+	if days := abs / uint64(24*time.Hour); days > 0 {
+		sb.WriteString(strconv.FormatUint(days, 10))
+		sb.WriteByte('D')
+	}
+
+	abs %= uint64(24 * time.Hour)
+
+	if abs > 0 {
+		sb.WriteByte('T')
+
+		for _, unit := range iso8601Designators[1][:2] {
+			if amount := abs / uint64(unit.one); amount > 0 {
+				sb.WriteString(strconv.FormatUint(amount, 10))
+				sb.WriteByte(unit.designator)
+			}
+
+			abs %= uint64(unit.one)
+		}
+
+		if abs > 0 {
+			sb.WriteString(strconv.FormatUint(abs/uint64(time.Second), 10))
+
+			if ns := abs % uint64(time.Second); ns > 0 {
+				fraction := strconv.FormatUint(ns+uint64(time.Second), 10)
+
+				sb.WriteByte('.')
+				sb.WriteString(strings.TrimRight(fraction[1:], "0"))
+			}
+
+			sb.WriteByte('S')
+		}
+	}
+
+	return sb.String()
+}
+
+type iso8601Designator struct {
+	designator byte
+	one        time.Duration
+}
+
+// iso8601Designators are the supported ones in the date and the time part.
+var iso8601Designators = [2][]iso8601Designator{
+	{{'W', 7 * 24 * time.Hour}, {'D', 24 * time.Hour}},
+	{{'H', time.Hour}, {'M', time.Minute}, {'S', time.Second}},
+}
+
+// ParseISO8601 parses ISO 8601 durations like "P1W", "PT1H2M3.5S" or "-P1D".
+// Years and months are rejected as their length varies.
+func ParseISO8601(s string) (Duration, error) {
+	p := parser{input: s}
+
+	if p.done() {
+		return 0, p.fail("empty duration")
+	}
+
+	negative := p.sign()
+
+	if p.done() || p.input[p.offset] != 'P' {
+		return 0, p.fail("expected P")
+	}
+
+	p.offset++
+
+	var sum Duration
+	components := 0
+	fractional := false
+
+	for part, designators := range iso8601Designators {
+		if part == 1 {
+			if p.done() {
+				break
+			}
+
+			if p.input[p.offset] != 'T' {
+				return 0, p.fail("expected T")
+			}
+
+			p.offset++
+
+			if p.done() {
+				return 0, p.fail("expected a number")
+			}
+		}
+
+		next := 0
+
+		for !p.done() && (part == 1 || p.input[p.offset] != 'T') {
+			if fractional {
+				return 0, p.fail("only the last component may have a fraction")
+			}
+
+			amountOffset := p.offset
+			if _, ok := p.uint(); !ok {
+				return 0, p.fail("expected a number")
+			}
+
+			if !p.done() && (p.input[p.offset] == '.' || p.input[p.offset] == ',') {
+				fractional = true
+				p.offset++
+
+				if _, ok := p.uint(); !ok {
+					return 0, p.fail("expected a number")
+				}
+			}
+
+			amount := strings.Replace(p.input[amountOffset:p.offset], ",", ".", 1)
+			if negative {
+				amount = "-" + amount
+			}
+
+			if p.done() {
+				return 0, p.fail("expected a designator")
+			}
+
+			designator := p.input[p.offset]
+			i := next
+
+			for i < len(designators) && designators[i].designator != designator {
+				i++
+			}
+
+			if i == len(designators) {
+				switch {
+				case designator == 'Y' || designator == 'M' && part == 0:
+					return 0, p.fail("years and months aren't supported")
+				case indexOfDesignator(designators[:next], designator) >= 0:
+					return 0, p.fail("designator out of order")
+				default:
+					return 0, p.fail("unknown designator")
+				}
+			}
+
+			next = i + 1
+			p.offset++
+
+			component, err := parseDecimal(amount, Duration(designators[i].one))
+			if err == nil && !addDurations(&sum, component) {
+				err = &ParseError{Msg: "duration out of range"}
+			}
+
+			if err != nil {
+				p.offset = amountOffset
+				return 0, p.fail(err.(*ParseError).Msg)
+			}
+
+			components++
+		}
+	}
+
+	if components == 0 {
+		return 0, p.fail("expected a number")
+	}
+
+	return sum, nil
+}
+
+func indexOfDesignator(designators []iso8601Designator, designator byte) int {
+	for i, d := range designators {
+		if d.designator == designator {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// addDurations adds b to *a unless that would overflow.
+func addDurations(a *Duration, b Duration) bool {
+	if b > 0 && *a > math.MaxInt64-b || b < 0 && *a < math.MinInt64-b {
+		return false
+	}
+
+	*a += b
+	return true
+}
+
+// ISODuration is a Duration marshaled as ISO 8601 string, e.g. "PT1H30M".
+type ISODuration Duration
+
+func (id ISODuration) String() string {
+	return Duration(id).ISO8601()
+}
+
+func (id ISODuration) MarshalText() ([]byte, error) {
+	return []byte(Duration(id).ISO8601()), nil
+}
+
+func (id *ISODuration) UnmarshalText(text []byte) error {
+	d, err := ParseISO8601(string(text))
+	if err == nil {
+		*id = ISODuration(d)
+	}
+
+	return err
+}
+
+func (id ISODuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(Duration(id).ISO8601())
+}
+
+func (id *ISODuration) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONString(data, id)
+}
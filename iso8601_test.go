@@ -0,0 +1,135 @@
+package go_pretty_print
+
+import (
+	"encoding/json"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDuration_ISO8601(t *testing.T) {
+	assertDuration_ISO8601(t, 0, "PT0S")
+
+	assertDuration_ISO8601(t, ns8, "PT0.000000008S")
+	assertDuration_ISO8601(t, us7, "PT0.000007S")
+	assertDuration_ISO8601(t, ms6, "PT0.006S")
+	assertDuration_ISO8601(t, s5, "PT5S")
+	assertDuration_ISO8601(t, m4, "PT4M")
+	assertDuration_ISO8601(t, h3, "PT3H")
+	assertDuration_ISO8601(t, d2, "P2D")
+	assertDuration_ISO8601(t, w1, "P7D")
+	assertDuration_ISO8601(t, d2+h3+m4+s5+500*time.Millisecond, "P2DT3H4M5.5S")
+	assertDuration_ISO8601(t, h3+s5, "PT3H5S")
+	assertDuration_ISO8601(t, w1+d2+h3+m4+s5+ms6+us7+ns8, "P9DT3H4M5.006007008S")
+
+	assertDuration_ISO8601(t, -s5, "-PT5S")
+	assertDuration_ISO8601(t, -d2-h3, "-P2DT3H")
+	assertDuration_ISO8601(t, math.MaxInt64, "P106751DT23H47M16.854775807S")
+	assertDuration_ISO8601(t, math.MinInt64, "-P106751DT23H47M16.854775808S")
+}
+
+func assertDuration_ISO8601(t *testing.T, d time.Duration, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Duration(%v).ISO8601()", []any{d}, []any{expected}, []any{Duration(d).ISO8601()})
+}
+
+func TestParseISO8601(t *testing.T) {
+	assertParseISO8601(t, "PT0S", 0)
+	assertParseISO8601(t, "P0D", 0)
+	assertParseISO8601(t, "-PT0S", 0)
+	assertParseISO8601(t, "P1W", w1)
+	assertParseISO8601(t, "P1W2D", w1+d2)
+	assertParseISO8601(t, "P2DT3H4M5.5S", d2+h3+m4+s5+500*time.Millisecond)
+	assertParseISO8601(t, "PT1H2M3.5S", time.Hour+2*time.Minute+3500*time.Millisecond)
+	assertParseISO8601(t, "PT1,5S", 1500*time.Millisecond)
+	assertParseISO8601(t, "PT1.5H", 90*time.Minute)
+	assertParseISO8601(t, "P1DT0.5M", d2/2+30*time.Second)
+	assertParseISO8601(t, "P0.5D", 12*time.Hour)
+	assertParseISO8601(t, "PT90M", 90*time.Minute)
+	assertParseISO8601(t, "PT0.0000000005S", time.Nanosecond)
+	assertParseISO8601(t, "+PT5S", s5)
+	assertParseISO8601(t, "-P2DT3H", -d2-h3)
+	assertParseISO8601(t, "P106751DT23H47M16.854775807S", math.MaxInt64)
+	assertParseISO8601(t, "-P106751DT23H47M16.854775808S", math.MinInt64)
+	assertParseISO8601(t, "-PT9223372036.854775808S", math.MinInt64)
+
+	for _, d := range [...]time.Duration{ns8, us7, ms6, s5, m4, h3, d2, w1, w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8} {
+		assertParseISO8601(t, Duration(d).ISO8601(), d)
+		assertParseISO8601(t, Duration(-d).ISO8601(), -d)
+	}
+}
+
+func assertParseISO8601(t *testing.T, s string, expected time.Duration) {
+	t.Helper()
+
+	d, err := ParseISO8601(s)
+	AssertCallResult(t, "ParseISO8601(%#v)", []any{s}, []any{Duration(expected), nil}, []any{d, err})
+}
+
+func TestParseISO8601_Error(t *testing.T) {
+	assertParseISO8601_Error(t, "", 0, "empty duration")
+	assertParseISO8601_Error(t, "1D", 0, "expected P")
+	assertParseISO8601_Error(t, "-", 1, "expected P")
+	assertParseISO8601_Error(t, "P", 1, "expected a number")
+	assertParseISO8601_Error(t, "PT", 2, "expected a number")
+	assertParseISO8601_Error(t, "P1", 2, "expected a designator")
+	assertParseISO8601_Error(t, "P1Y", 2, "years and months aren't supported")
+	assertParseISO8601_Error(t, "P1M", 2, "years and months aren't supported")
+	assertParseISO8601_Error(t, "PT1Y", 3, "years and months aren't supported")
+	assertParseISO8601_Error(t, "P1H", 2, "unknown designator")
+	assertParseISO8601_Error(t, "PT1D", 3, "unknown designator")
+	assertParseISO8601_Error(t, "P1D1W", 4, "designator out of order")
+	assertParseISO8601_Error(t, "PT1S1M", 5, "designator out of order")
+	assertParseISO8601_Error(t, "PT1M1M", 5, "designator out of order")
+	assertParseISO8601_Error(t, "P1DT", 4, "expected a number")
+	assertParseISO8601_Error(t, "P1D2", 4, "expected a designator")
+	assertParseISO8601_Error(t, "P1DX", 3, "expected a number")
+	assertParseISO8601_Error(t, "PT1.S", 4, "expected a number")
+	assertParseISO8601_Error(t, "PT1.5M3S", 6, "only the last component may have a fraction")
+	assertParseISO8601_Error(t, "P0.5DT1H", 6, "only the last component may have a fraction")
+	assertParseISO8601_Error(t, "PT1HT1M", 4, "expected a number")
+	assertParseISO8601_Error(t, "P106752D", 1, "duration out of range")
+	assertParseISO8601_Error(t, "P106751DT23H47M16.854775808S", 15, "duration out of range")
+}
+
+func assertParseISO8601_Error(t *testing.T, s string, offset int, msg string) {
+	t.Helper()
+
+	d, err := ParseISO8601(s)
+	AssertCallResult(t, "ParseISO8601(%#v)", []any{s}, []any{Duration(0), &ParseError{s, offset, msg}}, []any{d, err})
+}
+
+func TestISODuration_JSON(t *testing.T) {
+	type contract struct {
+		Timeout ISODuration `json:"timeout"`
+	}
+
+	jsn, err := json.Marshal(contract{ISODuration(h3 + m4 + 500*time.Millisecond)})
+	AssertCallResult(
+		t, "json.Marshal(%v)", []any{h3 + m4 + 500*time.Millisecond},
+		[]any{`{"timeout":"PT3H4M0.5S"}`, nil}, []any{string(jsn), err},
+	)
+
+	var c contract
+	err = json.Unmarshal([]byte(`{"timeout":"-P1W"}`), &c)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"timeout":"-P1W"}`}, []any{ISODuration(-w1), nil}, []any{c.Timeout, err})
+
+	err = json.Unmarshal([]byte(`{"timeout":"1h"}`), &c)
+	AssertCallResult(
+		t, "json.Unmarshal(%#v)", []any{`{"timeout":"1h"}`},
+		[]any{ISODuration(-w1), error(&ParseError{"1h", 0, "expected P"})}, []any{c.Timeout, err},
+	)
+}
+
+func TestISODuration_Text(t *testing.T) {
+	AssertCallResult(t, "ISODuration(%v).String()", []any{d2}, []any{"P2D"}, []any{ISODuration(d2).String()})
+
+	text, err := ISODuration(-ms6).MarshalText()
+	AssertCallResult(t, "ISODuration(%v).MarshalText()", []any{-ms6}, []any{[]byte("-PT0.006S"), nil}, []any{text, err})
+
+	var id ISODuration
+	err = id.UnmarshalText([]byte("PT6M"))
+	AssertCallResult(t, "ISODuration.UnmarshalText(%#v)", []any{"PT6M"}, []any{ISODuration(6 * time.Minute), nil}, []any{id, err})
+}
@@ -0,0 +1,394 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// PluralCategory is a CLDR plural category.
+type PluralCategory uint8
+
+const (
+	PluralOther PluralCategory = iota
+	PluralZero
+	PluralOne
+	PluralTwo
+	PluralFew
+	PluralMany
+)
+
+// UnitNames holds CLDR-like patterns such as "{0} days" per PluralCategory.
+// PluralOther is the fallback for missing ones.
+type UnitNames struct {
+	Short, Long [6]string
+}
+
+// ListPattern joins segments like "1 week, 2 days and 3 hours".
+type ListPattern struct {
+	Separator, Last string
+}
+
+// RelativePatterns phrase durations relative to now, e.g. "in {0}" and "{0} ago".
+type RelativePatterns struct {
+	Future, Past, Now string
+	// Units override Locale.Units inside Future and Past, e.g. for the grammatical case.
+	Units map[string]UnitNames
+	// Singular overrides ApproximatePatterns.Singular inside Future and Past.
+	Singular map[string]string
+}
+
+// ApproximatePatterns phrase durations fuzzily, e.g. "about {0}" with {0} being like "2 days".
+type ApproximatePatterns struct {
+	About, Over, Almost, LessThan string
+	// Few is printed instead of tiny durations, e.g. "a few seconds".
+	Few string
+	// Singular replaces an amount of 1 of a unit, keyed by DurationUnit.Symbol, e.g. "an hour" for "h".
+	Singular map[string]string
+}
+
+// Locale describes how to print durations in a language.
+type Locale struct {
+	// Tag is a BCP 47 language tag, e.g. "de" or "de-AT".
+	Tag string
+	// Plural returns the category of an amount. If nil, amounts of 1 are PluralOne and others PluralOther.
+	Plural func(n uint64) PluralCategory
+	// Units are keyed by DurationUnit.Symbol, e.g. "d".
+	Units                 map[string]UnitNames
+	ShortList, LongList   ListPattern
+	GroupSeparator        string
+	MinimumGroupingDigits int
+	// Approximate is used by the Approximate Style. Without About, everything is printed in English.
+	Approximate ApproximatePatterns
+	// Relative is used by RelativeFormatter. Missing patterns are taken from English.
+	Relative RelativePatterns
+}
+
+// LocalizedDuration is a Duration printed in a Locale by String and Format.
+// Only %s, %v and %a are localized, the other verbs (e.g. %u, %c and %f) print like Duration.Format.
+type LocalizedDuration struct {
+	Duration
+	Locale *Locale
+}
+
+func (ld LocalizedDuration) String() string {
+	return NewFormatter().WithLocale(ld.Locale).Format(ld.Duration)
+}
+
+func (ld LocalizedDuration) Format(f fmt.State, c rune) {
+	ld.Duration.format(f, c, NewFormatter().WithLocale(ld.Locale))
+}
+
+// Localized returns dur printed in the given Locale.
+func (dur Duration) Localized(locale *Locale) LocalizedDuration {
+	return LocalizedDuration{dur, locale}
+}
+
+var locales = struct {
+	sync.RWMutex
+	byTag map[string]*Locale
+}{byTag: map[string]*Locale{}}
+
+// RegisterLocale makes l available to LookupLocale, replacing any Locale with the same Tag.
+// l must not be modified afterwards.
+func RegisterLocale(l *Locale) {
+	locales.Lock()
+	defer locales.Unlock()
+
+	locales.byTag[normalizeLocaleTag(l.Tag)] = l
+}
+
+// LookupLocale returns the Locale registered for tag or its closest parent, e.g. "de" for "de-AT".
+// It's shared with all users of the package, so treat it as read-only and modify copies only.
+func LookupLocale(tag string) (*Locale, bool) {
+	locales.RLock()
+	defer locales.RUnlock()
+
+	for tag = normalizeLocaleTag(tag); ; {
+		if l, ok := locales.byTag[tag]; ok {
+			return l, true
+		}
+
+		i := strings.LastIndexByte(tag, '-')
+		if i < 0 {
+			return nil, false
+		}
+
+		tag = tag[:i]
+	}
+}
+
+func normalizeLocaleTag(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(tag, "_", "-"))
+}
+
+func (l *Locale) appendUnit(dst []byte, unit DurationUnit, amount uint64, style Style, relative bool) []byte {
+	names, ok := l.Relative.Units[unit.Symbol]
+	if !ok || !relative {
+		names, ok = l.Units[unit.Symbol]
+		if !ok {
+			names = unit.Names
+		}
+	}
+
+	return l.appendNames(dst, &names, unit.Symbol, amount, style)
+}
+
+// appendNames appends amount with the pattern of names for style, or with symbol if there's none.
+func (l *Locale) appendNames(dst []byte, names *UnitNames, symbol string, amount uint64, style Style) []byte {
+	plural := l.Plural
+	if plural == nil {
+		plural = pluralOneOther
+	}
+
+	pattern := names.pattern(style, plural(amount))
+	if pattern == "" {
+		return append(l.appendUint(dst, amount), symbol...)
+	}
+
+	i := strings.Index(pattern, "{0}")
+	if i < 0 {
+		return append(dst, pattern...)
+	}
+
+	dst = append(dst, pattern[:i]...)
+	dst = l.appendUint(dst, amount)
+	return append(dst, pattern[i+3:]...)
+}
+
+// pattern returns the pattern for style and category, falling back to PluralOther.
+func (names *UnitNames) pattern(style Style, category PluralCategory) string {
+	patterns := &names.Short
+	if style == Long {
+		patterns = &names.Long
+	}
+
+	if pattern := patterns[category]; pattern != "" {
+		return pattern
+	}
+
+	return patterns[PluralOther]
+}
+
+func (l *Locale) appendUint(dst []byte, n uint64) []byte {
+	var buf [20]byte
+	digits := strconv.AppendUint(buf[:0], n, 10)
+
+	minGrouping := l.MinimumGroupingDigits
+	if minGrouping < 1 {
+		minGrouping = 1
+	}
+
+	if l.GroupSeparator == "" || len(digits) < 3+minGrouping {
+		return append(dst, digits...)
+	}
+
+	head := len(digits) % 3
+	if head == 0 {
+		head = 3
+	}
+
+	dst = append(dst, digits[:head]...)
+
+	for i := head; i < len(digits); i += 3 {
+		dst = append(dst, l.GroupSeparator...)
+		dst = append(dst, digits[i:i+3]...)
+	}
+
+	return dst
+}
+
+func pluralOneOther(n uint64) PluralCategory {
+	if n == 1 {
+		return PluralOne
+	}
+
+	return PluralOther
+}
+
+func pluralRussian(n uint64) PluralCategory {
+	switch mod10, mod100 := n%10, n%100; {
+	case mod10 == 1 && mod100 != 11:
+		return PluralOne
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+func pluralPolish(n uint64) PluralCategory {
+	switch mod10, mod100 := n%10, n%100; {
+	case n == 1:
+		return PluralOne
+	case mod10 >= 2 && mod10 <= 4 && (mod100 < 12 || mod100 > 14):
+		return PluralFew
+	default:
+		return PluralMany
+	}
+}
+
+func oneOther(one, other string) [6]string {
+	return [6]string{PluralOther: other, PluralOne: one}
+}
+
+func oneFewMany(one, few, many string) [6]string {
+	return [6]string{PluralOther: few, PluralOne: one, PluralFew: few, PluralMany: many}
+}
+
+func same(pattern string) [6]string {
+	return [6]string{PluralOther: pattern}
+}
+
+var localeEnglish = &Locale{
+	Tag:    "en",
+	Plural: pluralOneOther,
+	Units: map[string]UnitNames{
+		"y":  {same("{0}y"), oneOther("{0} year", "{0} years")},
+		"mo": {same("{0}mo"), oneOther("{0} month", "{0} months")},
+		"w":  {same("{0}w"), oneOther("{0} week", "{0} weeks")},
+		"d":  {same("{0}d"), oneOther("{0} day", "{0} days")},
+		"h":  {same("{0}h"), oneOther("{0} hour", "{0} hours")},
+		"m":  {same("{0}m"), oneOther("{0} minute", "{0} minutes")},
+		"s":  {same("{0}s"), oneOther("{0} second", "{0} seconds")},
+		"ms": {same("{0}ms"), oneOther("{0} millisecond", "{0} milliseconds")},
+		"us": {same("{0}us"), oneOther("{0} microsecond", "{0} microseconds")},
+		"ns": {same("{0}ns"), oneOther("{0} nanosecond", "{0} nanoseconds")},
+	},
+	ShortList: ListPattern{" ", " "},
+	LongList:  ListPattern{" ", " "},
+	Approximate: ApproximatePatterns{
+		About:    "about {0}",
+		Over:     "over {0}",
+		Almost:   "almost {0}",
+		LessThan: "less than {0}",
+		Few:      "a few seconds",
+		Singular: map[string]string{
+			"y": "a year", "mo": "a month", "w": "a week", "d": "a day", "h": "an hour", "m": "a minute",
+			"s": "a second", "ms": "a millisecond", "us": "a microsecond", "ns": "a nanosecond",
+		},
+	},
+	Relative: RelativePatterns{Future: "in {0}", Past: "{0} ago", Now: "just now"},
+}
+
+func init() {
+	RegisterLocale(localeEnglish)
+
+	RegisterLocale(&Locale{
+		Tag:    "de",
+		Plural: pluralOneOther,
+		Units: map[string]UnitNames{
+			"y":  {same("{0} J."), oneOther("{0} Jahr", "{0} Jahre")},
+			"mo": {same("{0} Mon."), oneOther("{0} Monat", "{0} Monate")},
+			"w":  {same("{0} Wo."), oneOther("{0} Woche", "{0} Wochen")},
+			"d":  {same("{0} Tg."), oneOther("{0} Tag", "{0} Tage")},
+			"h":  {same("{0} Std."), oneOther("{0} Stunde", "{0} Stunden")},
+			"m":  {same("{0} Min."), oneOther("{0} Minute", "{0} Minuten")},
+			"s":  {same("{0} Sek."), oneOther("{0} Sekunde", "{0} Sekunden")},
+			"ms": {same("{0} ms"), oneOther("{0} Millisekunde", "{0} Millisekunden")},
+			"us": {same("{0} μs"), oneOther("{0} Mikrosekunde", "{0} Mikrosekunden")},
+			"ns": {same("{0} ns"), oneOther("{0} Nanosekunde", "{0} Nanosekunden")},
+		},
+		ShortList:      ListPattern{", ", ", "},
+		LongList:       ListPattern{", ", " und "},
+		GroupSeparator: ".",
+		Approximate: ApproximatePatterns{
+			About:    "etwa {0}",
+			Over:     "über {0}",
+			Almost:   "fast {0}",
+			LessThan: "weniger als {0}",
+			Few:      "ein paar Sekunden",
+			Singular: map[string]string{
+				"y": "ein Jahr", "mo": "ein Monat", "w": "eine Woche", "d": "ein Tag", "h": "eine Stunde",
+				"m": "eine Minute", "s": "eine Sekunde", "ms": "eine Millisekunde", "us": "eine Mikrosekunde",
+				"ns": "eine Nanosekunde",
+			},
+		},
+		Relative: RelativePatterns{
+			Future: "in {0}",
+			Past:   "vor {0}",
+			Now:    "gerade eben",
+			// Dative
+			Units: map[string]UnitNames{
+				"y":  {same("{0} J."), oneOther("{0} Jahr", "{0} Jahren")},
+				"mo": {same("{0} Mon."), oneOther("{0} Monat", "{0} Monaten")},
+				"d":  {same("{0} Tg."), oneOther("{0} Tag", "{0} Tagen")},
+			},
+			Singular: map[string]string{
+				"y": "einem Jahr", "mo": "einem Monat", "w": "einer Woche", "d": "einem Tag", "h": "einer Stunde",
+				"m": "einer Minute", "s": "einer Sekunde", "ms": "einer Millisekunde", "us": "einer Mikrosekunde",
+				"ns": "einer Nanosekunde",
+			},
+		},
+	})
+
+	RegisterLocale(&Locale{
+		Tag:    "ru",
+		Plural: pluralRussian,
+		Units: map[string]UnitNames{
+			"y":  {same("{0} г."), oneFewMany("{0} год", "{0} года", "{0} лет")},
+			"mo": {same("{0} мес."), oneFewMany("{0} месяц", "{0} месяца", "{0} месяцев")},
+			"w":  {same("{0} нед."), oneFewMany("{0} неделя", "{0} недели", "{0} недель")},
+			"d":  {same("{0} дн."), oneFewMany("{0} день", "{0} дня", "{0} дней")},
+			"h":  {same("{0} ч"), oneFewMany("{0} час", "{0} часа", "{0} часов")},
+			"m":  {same("{0} мин"), oneFewMany("{0} минута", "{0} минуты", "{0} минут")},
+			"s":  {same("{0} с"), oneFewMany("{0} секунда", "{0} секунды", "{0} секунд")},
+			"ms": {same("{0} мс"), oneFewMany("{0} миллисекунда", "{0} миллисекунды", "{0} миллисекунд")},
+			"us": {same("{0} мкс"), oneFewMany("{0} микросекунда", "{0} микросекунды", "{0} микросекунд")},
+			"ns": {same("{0} нс"), oneFewMany("{0} наносекунда", "{0} наносекунды", "{0} наносекунд")},
+		},
+		ShortList:      ListPattern{" ", " "},
+		LongList:       ListPattern{", ", " и "},
+		GroupSeparator: "\u00a0",
+		Relative: RelativePatterns{
+			Future: "через {0}",
+			Past:   "{0} назад",
+			Now:    "только что",
+			// Accusative
+			Units: map[string]UnitNames{
+				"w":  {same("{0} нед."), oneFewMany("{0} неделю", "{0} недели", "{0} недель")},
+				"m":  {same("{0} мин"), oneFewMany("{0} минуту", "{0} минуты", "{0} минут")},
+				"s":  {same("{0} с"), oneFewMany("{0} секунду", "{0} секунды", "{0} секунд")},
+				"ms": {same("{0} мс"), oneFewMany("{0} миллисекунду", "{0} миллисекунды", "{0} миллисекунд")},
+				"us": {same("{0} мкс"), oneFewMany("{0} микросекунду", "{0} микросекунды", "{0} микросекунд")},
+				"ns": {same("{0} нс"), oneFewMany("{0} наносекунду", "{0} наносекунды", "{0} наносекунд")},
+			},
+		},
+	})
+
+	RegisterLocale(&Locale{
+		Tag:    "pl",
+		Plural: pluralPolish,
+		Units: map[string]UnitNames{
+			"y":  {same("{0} r."), oneFewMany("{0} rok", "{0} lata", "{0} lat")},
+			"mo": {same("{0} mies."), oneFewMany("{0} miesiąc", "{0} miesiące", "{0} miesięcy")},
+			"w":  {same("{0} tydz."), oneFewMany("{0} tydzień", "{0} tygodnie", "{0} tygodni")},
+			"d":  {oneOther("{0} dzień", "{0} dni"), oneOther("{0} dzień", "{0} dni")},
+			"h":  {same("{0} godz."), oneFewMany("{0} godzina", "{0} godziny", "{0} godzin")},
+			"m":  {same("{0} min"), oneFewMany("{0} minuta", "{0} minuty", "{0} minut")},
+			"s":  {same("{0} sek."), oneFewMany("{0} sekunda", "{0} sekundy", "{0} sekund")},
+			"ms": {same("{0} ms"), oneFewMany("{0} milisekunda", "{0} milisekundy", "{0} milisekund")},
+			"us": {same("{0} μs"), oneFewMany("{0} mikrosekunda", "{0} mikrosekundy", "{0} mikrosekund")},
+			"ns": {same("{0} ns"), oneFewMany("{0} nanosekunda", "{0} nanosekundy", "{0} nanosekund")},
+		},
+		ShortList:             ListPattern{" ", " "},
+		LongList:              ListPattern{", ", " i "},
+		GroupSeparator:        "\u00a0",
+		MinimumGroupingDigits: 2,
+		Relative: RelativePatterns{
+			Future: "za {0}",
+			Past:   "{0} temu",
+			Now:    "przed chwilą",
+			// Accusative
+			Units: map[string]UnitNames{
+				"h":  {same("{0} godz."), oneFewMany("{0} godzinę", "{0} godziny", "{0} godzin")},
+				"m":  {same("{0} min"), oneFewMany("{0} minutę", "{0} minuty", "{0} minut")},
+				"s":  {same("{0} sek."), oneFewMany("{0} sekundę", "{0} sekundy", "{0} sekund")},
+				"ms": {same("{0} ms"), oneFewMany("{0} milisekundę", "{0} milisekundy", "{0} milisekund")},
+				"us": {same("{0} μs"), oneFewMany("{0} mikrosekundę", "{0} mikrosekundy", "{0} mikrosekund")},
+				"ns": {same("{0} ns"), oneFewMany("{0} nanosekundę", "{0} nanosekundy", "{0} nanosekund")},
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"testing"
+	"time"
+)
+
+func TestLookupLocale(t *testing.T) {
+	assertLookupLocale(t, "en", "en")
+	assertLookupLocale(t, "de", "de")
+	assertLookupLocale(t, "de-AT", "de")
+	assertLookupLocale(t, "de_CH", "de")
+	assertLookupLocale(t, "RU", "ru")
+	assertLookupLocale(t, "pl-PL", "pl")
+	assertLookupLocale(t, "xx", "")
+	assertLookupLocale(t, "", "")
+}
+
+func assertLookupLocale(t *testing.T, tag, expected string) {
+	t.Helper()
+
+	actual := ""
+	if l, ok := LookupLocale(tag); ok {
+		actual = l.Tag
+	}
+
+	AssertCallResult(t, "LookupLocale(%#v)", []any{tag}, []any{expected}, []any{actual})
+}
+
+func TestRegisterLocale(t *testing.T) {
+	eo := &Locale{
+		Tag:    "eo",
+		Plural: pluralOneOther,
+		Units: map[string]UnitNames{
+			"d": {same("{0} t"), oneOther("{0} tago", "{0} tagoj")},
+			"h": {same("{0} h"), oneOther("{0} horo", "{0} horoj")},
+		},
+		ShortList: ListPattern{" ", " "},
+		LongList:  ListPattern{", ", " kaj "},
+	}
+
+	RegisterLocale(eo)
+	defer func() {
+		locales.Lock()
+		defer locales.Unlock()
+
+		delete(locales.byTag, "eo")
+	}()
+
+	l, ok := LookupLocale("eo-XX")
+	AssertCallResult(t, "LookupLocale(%#v)", []any{"eo-XX"}, []any{eo, true}, []any{l, ok})
+
+	assertLocalizedDuration(t, d2+h3, "eo", "%s", "2 t 3 h")
+	assertLocalizedDuration(t, d2/2+time.Hour, "eo", "%#s", "1 tago kaj 1 horo")
+}
+
+func TestLocalizedDuration_String(t *testing.T) {
+	assertLocalizedDuration_String(t, w1+d2+h3, "en", "1w 2d")
+	assertLocalizedDuration_String(t, w1+d2+h3, "de", "1 Wo., 2 Tg.")
+	assertLocalizedDuration_String(t, w1+d2+h3, "ru", "1 нед. 2 дн.")
+	assertLocalizedDuration_String(t, w1+d2+h3, "pl", "1 tydz. 2 dni")
+	assertLocalizedDuration_String(t, 0, "de", "0 Sek.")
+	assertLocalizedDuration_String(t, -h3, "ru", "-3 ч")
+}
+
+func assertLocalizedDuration_String(t *testing.T, d time.Duration, tag, expected string) {
+	t.Helper()
+
+	l, _ := LookupLocale(tag)
+	AssertCallResult(
+		t, "Duration(%v).Localized(%s).String()", []any{d, tag}, []any{expected},
+		[]any{Duration(d).Localized(l).String()},
+	)
+}
+
+func TestLocalizedDuration_Format(t *testing.T) {
+	assertLocalizedDuration(t, w1+d2+h3, "en", "%#.2s", "1 week 2 days 3 hours")
+	assertLocalizedDuration(t, w1+d2+h3, "de", "%#.2s", "1 Woche, 2 Tage und 3 Stunden")
+	assertLocalizedDuration(t, w1+d2+h3, "ru", "%#.2s", "1 неделя, 2 дня и 3 часа")
+	assertLocalizedDuration(t, w1+d2+h3, "pl", "%#.2s", "1 tydzień, 2 dni i 3 godziny")
+	assertLocalizedDuration(t, d2/2+h3, "pl", "%s", "1 dzień 3 godz.")
+	assertLocalizedDuration(t, 0, "ru", "%#s", "0 секунд")
+	assertLocalizedDuration(t, -time.Minute, "de", "%#s", "-1 Minute")
+	assertLocalizedDuration(t, s5, "de", "%.1f", "5.0")
+	assertLocalizedDuration(t, time.Hour+15*time.Minute, "de", "%u", "1.25h")
+	assertLocalizedDuration(t, time.Hour+15*time.Minute, "de", "%c", "1:15:00")
+	assertLocalizedDuration(t, time.Hour+15*time.Minute, "de", "%a", "über eine Stunde")
+
+	for _, c := range [...]struct {
+		minutes        time.Duration
+		ru, pl, en, de string
+	}{
+		{1, "1 минута", "1 minuta", "1 minute", "1 Minute"},
+		{2, "2 минуты", "2 minuty", "2 minutes", "2 Minuten"},
+		{4, "4 минуты", "4 minuty", "4 minutes", "4 Minuten"},
+		{5, "5 минут", "5 minut", "5 minutes", "5 Minuten"},
+		{11, "11 минут", "11 minut", "11 minutes", "11 Minuten"},
+		{12, "12 минут", "12 minut", "12 minutes", "12 Minuten"},
+		{21, "21 минута", "21 minut", "21 minutes", "21 Minuten"},
+		{22, "22 минуты", "22 minuty", "22 minutes", "22 Minuten"},
+		{25, "25 минут", "25 minut", "25 minutes", "25 Minuten"},
+	} {
+		d := c.minutes * time.Minute
+
+		assertLocalizedDuration(t, d, "ru", "%#s", c.ru)
+		assertLocalizedDuration(t, d, "pl", "%#s", c.pl)
+		assertLocalizedDuration(t, d, "en", "%#s", c.en)
+		assertLocalizedDuration(t, d, "de", "%#s", c.de)
+	}
+
+	assertLocalizedDuration(t, 111*w1, "ru", "%#s", "111 недель")
+	assertLocalizedDuration(t, 111*w1, "pl", "%#s", "111 tygodni")
+	assertLocalizedDuration(t, 1001*w1, "ru", "%#s", "1\u00a0001 неделя")
+	assertLocalizedDuration(t, 1001*w1, "pl", "%#s", "1001 tygodni")
+	assertLocalizedDuration(t, 10002*w1, "pl", "%#s", "10\u00a0002 tygodnie")
+	assertLocalizedDuration(t, 1001*w1, "de", "%#s", "1.001 Wochen")
+	assertLocalizedDuration(t, 1001*w1, "en", "%#s", "1001 weeks")
+}
+
+func assertLocalizedDuration(t *testing.T, d time.Duration, tag, format, expected string) {
+	t.Helper()
+
+	l, _ := LookupLocale(tag)
+	AssertCallResult(
+		t,
+		"fmt.Sprintf(%#v, Duration(%v).Localized(%s))",
+		[]any{format, d, tag},
+		[]any{expected},
+		[]any{fmt.Sprintf(format, Duration(d).Localized(l))},
+	)
+}
+
+func TestLocale_NoPlural(t *testing.T) {
+	l := &Locale{Tag: "xx", Units: map[string]UnitNames{"d": {Long: oneOther("{0} dag", "{0} dagen")}}}
+
+	for _, c := range [...]struct {
+		d        time.Duration
+		expected string
+	}{
+		{d2 / 2, "1 dag"},
+		{d2, "2 dagen"},
+		{h3, "3h"},
+	} {
+		AssertCallResult(
+			t, "fmt.Sprintf(%#v, Duration(%v).Localized(&Locale{Tag: %#v}))", []any{"%#s", c.d, "xx"}, []any{c.expected},
+			[]any{fmt.Sprintf("%#s", Duration(c.d).Localized(l))},
+		)
+	}
+}
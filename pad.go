@@ -0,0 +1,108 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// writePadded writes s to f honoring the width and the flags '-', '+' and ' '.
+// A leading '-' in s is treated as sign, prefix (e.g. "0x") follows the sign
+// and zero tells whether to pad with zeros after the prefix rather than with spaces.
+func writePadded(f fmt.State, s, prefix string, zero bool) {
+	sign := ""
+
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, s = "-", s[1:]
+	case f.Flag('+'):
+		sign = "+"
+	case f.Flag(' '):
+		sign = " "
+	}
+
+	sign += prefix
+	width, _ := f.Width()
+	padding := width - utf8.RuneCountInString(sign) - utf8.RuneCountInString(s)
+
+	switch {
+	case padding <= 0:
+		io.WriteString(f, sign+s)
+	case f.Flag('-'):
+		io.WriteString(f, sign+s+strings.Repeat(" ", padding))
+	case zero:
+		io.WriteString(f, sign+strings.Repeat("0", padding)+s)
+	default:
+		io.WriteString(f, strings.Repeat(" ", padding)+sign+s)
+	}
+}
+
+// writeText writes s to f honoring only the width and the flag '-', i.e. treats it as text, not as number.
+func writeText(f fmt.State, s string) {
+	width, _ := f.Width()
+	padding := width - utf8.RuneCountInString(s)
+
+	switch {
+	case padding <= 0:
+		io.WriteString(f, s)
+	case f.Flag('-'):
+		io.WriteString(f, s+strings.Repeat(" ", padding))
+	default:
+		io.WriteString(f, strings.Repeat(" ", padding)+s)
+	}
+}
+
+// writeInt writes abs for the integer verbs d, o, O, x and X honoring the precision as minimum digits
+// and the '#' flag for prefixes. It never writes "-0".
+func writeInt(f fmt.State, c rune, negative bool, abs uint64) {
+	base := 16
+	prefix := ""
+
+	switch c {
+	case 'd':
+		base = 10
+	case 'o', 'O':
+		base = 8
+		if c == 'O' {
+			prefix = "0o"
+		} else if f.Flag('#') {
+			prefix = "0"
+		}
+	case 'x':
+		if f.Flag('#') {
+			prefix = "0x"
+		}
+	case 'X':
+		if f.Flag('#') {
+			prefix = "0X"
+		}
+	}
+
+	digits := strconv.FormatUint(abs, base)
+	if c == 'X' {
+		digits = strings.ToUpper(digits)
+	}
+
+	prec, hasPrec := f.Precision()
+	if hasPrec {
+		if prec == 0 && abs == 0 {
+			digits = ""
+		}
+
+		if len(digits) < prec {
+			digits = strings.Repeat("0", prec-len(digits)) + digits
+		}
+	}
+
+	if prefix == "0" && strings.HasPrefix(digits, "0") {
+		prefix = ""
+	}
+
+	if negative && abs != 0 {
+		digits = "-" + digits
+	}
+
+	writePadded(f, digits, prefix, f.Flag('0') && !hasPrec)
+}
@@ -0,0 +1,269 @@
+package go_pretty_print
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"time"
+)
+
+// ParseError describes why and where a string couldn't be parsed.
+type ParseError struct {
+	Input  string
+	Offset int
+	Msg    string
+}
+
+func (pe *ParseError) Error() string {
+	return fmt.Sprintf("go_pretty_print: %s at offset %d of %q", pe.Msg, pe.Offset, pe.Input)
+}
+
+var durationUnitAliases = map[string]uint8{"µs": 6, "μs": 6}
+
+// ParseDuration parses strings like "-1w 2d 3h" as produced by Duration.String.
+func ParseDuration(s string) (Duration, error) {
+	p := parser{input: s}
+	p.skipSpaces()
+
+	if p.done() {
+		return 0, p.fail("empty duration")
+	}
+
+	negative := p.sign()
+	limit := uint64(math.MaxInt64)
+	if negative {
+		limit++
+	}
+
+	if p.input[p.offset:] == "0" {
+		return 0, nil
+	}
+
+	var abs uint64
+	nextUnit := uint8(0)
+
+	for {
+		p.skipSpaces()
+
+		if p.done() {
+			break
+		}
+
+		amountOffset := p.offset
+		amount, ok := p.uint()
+		if !ok {
+			return 0, p.fail("expected a number")
+		}
+
+		p.skipSpaces()
+
+		unitOffset := p.offset
+		unit, ok := p.durationUnit()
+		if !ok {
+			if p.offset == unitOffset {
+				return 0, p.fail("missing unit")
+			}
+
+			p.offset = unitOffset
+			return 0, p.fail("unknown unit")
+		}
+
+		if unit < nextUnit {
+			p.offset = unitOffset
+			return 0, p.fail("unit out of order")
+		}
+
+		nextUnit = unit + 1
+		one := uint64(durationUnits[unit].One)
+
+		if amount > limit/one || abs > limit-amount*one {
+			p.offset = amountOffset
+			return 0, p.fail("duration out of range")
+		}
+
+		abs += amount * one
+	}
+
+	if nextUnit == 0 {
+		return 0, p.fail("expected a number")
+	}
+
+	if negative {
+		abs = -abs
+	}
+
+	return Duration(abs), nil
+}
+
+// parseDurationText parses s as ParseDuration does, falling back to time.ParseDuration.
+func parseDurationText(s string) (Duration, error) {
+	d, err := ParseDuration(s)
+	if err != nil {
+		if td, errTD := time.ParseDuration(s); errTD == nil {
+			return Duration(td), nil
+		}
+	}
+
+	return d, err
+}
+
+var minInt64 = big.NewInt(math.MinInt64)
+var maxInt64 = big.NewInt(math.MaxInt64)
+
+// parseDecimal parses a decimal number of units exactly and rounds it half away from zero to nanoseconds.
+func parseDecimal(s string, unit Duration) (Duration, error) {
+	n, err := parseScaled(s, int64(unit), "duration")
+	return Duration(n), err
+}
+
+// parseScaled parses a decimal number of units exactly and rounds it half away from zero to an integer
+// of the smallest unit. quantity names what is out of range in errors.
+func parseScaled(s string, unit int64, quantity string) (int64, error) {
+	// Bail out early on huge exponents, big.Rat would expand them.
+	if f, err := strconv.ParseFloat(s, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+		switch f = math.Abs(f) * float64(unit); {
+		case f > 1e19:
+			return 0, &ParseError{s, 0, quantity + " out of range"}
+		case f < 0.1:
+			return 0, nil
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return 0, &ParseError{s, 0, "expected a number"}
+	}
+
+	n := roundRat(r.Mul(r, new(big.Rat).SetInt64(unit)))
+	if n.Cmp(minInt64) < 0 || n.Cmp(maxInt64) > 0 {
+		return 0, &ParseError{s, 0, quantity + " out of range"}
+	}
+
+	return n.Int64(), nil
+}
+
+// unixToInternal is the amount of seconds between the years 1 and 1970, where time.Time and Unix time start.
+const unixToInternal = (1969*365 + 1969/4 - 1969/100 + 1969/400) * 24 * 60 * 60
+
+var maxUnixSeconds = big.NewInt(math.MaxInt64 - unixToInternal)
+var nanosPerSecond = big.NewInt(int64(time.Second))
+
+// parseUnixTime parses a decimal number of units since the Unix epoch exactly
+// and rounds it half away from zero to nanoseconds.
+// Unlike parseDecimal it covers all seconds time.Unix can handle, not just the range of Duration.
+func parseUnixTime(s string, unit Duration) (Time, error) {
+	// Bail out early on huge exponents, big.Rat would expand them.
+	if f, err := strconv.ParseFloat(s, 64); err == nil || errors.Is(err, strconv.ErrRange) {
+		switch f = math.Abs(f) * float64(unit); {
+		case f > 1e29:
+			return Time{}, &ParseError{s, 0, "time out of range"}
+		case f < 0.1:
+			return Time(time.Unix(0, 0)), nil
+		}
+	}
+
+	r, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return Time{}, &ParseError{s, 0, "expected a number"}
+	}
+
+	sec, nsec := new(big.Int).DivMod(roundRat(r.Mul(r, new(big.Rat).SetInt64(int64(unit)))), nanosPerSecond, new(big.Int))
+	if sec.Cmp(minInt64) < 0 || sec.Cmp(maxUnixSeconds) > 0 {
+		return Time{}, &ParseError{s, 0, "time out of range"}
+	}
+
+	return Time(time.Unix(sec.Int64(), nsec.Int64())), nil
+}
+
+// roundRat rounds r half away from zero to an integer.
+func roundRat(r *big.Rat) *big.Int {
+	n, rem := new(big.Int).QuoRem(r.Num(), r.Denom(), new(big.Int))
+	if rem.Lsh(rem.Abs(rem), 1).Cmp(r.Denom()) >= 0 {
+		n.Add(n, big.NewInt(int64(r.Sign())))
+	}
+
+	return n
+}
+
+type parser struct {
+	input  string
+	offset int
+}
+
+func (p *parser) done() bool {
+	return p.offset >= len(p.input)
+}
+
+func (p *parser) fail(msg string) *ParseError {
+	return &ParseError{p.input, p.offset, msg}
+}
+
+func (p *parser) skipSpaces() {
+	for !p.done() && p.input[p.offset] == ' ' {
+		p.offset++
+	}
+}
+
+func (p *parser) sign() (negative bool) {
+	if !p.done() {
+		switch p.input[p.offset] {
+		case '-':
+			negative = true
+			fallthrough
+		case '+':
+			p.offset++
+		}
+	}
+
+	return
+}
+
+func (p *parser) uint() (uint64, bool) {
+	start := p.offset
+	var value uint64
+
+	for ; !p.done() && isDigit(p.input[p.offset]); p.offset++ {
+		digit := uint64(p.input[p.offset] - '0')
+
+		if value > (math.MaxUint64-digit)/10 {
+			value = math.MaxUint64
+		} else {
+			value = value*10 + digit
+		}
+	}
+
+	return value, p.offset > start
+}
+
+func (p *parser) word() string {
+	start := p.offset
+
+	for !p.done() {
+		if c := p.input[p.offset]; c == ' ' || c == '+' || c == '-' || c == '.' || c == ':' || isDigit(c) {
+			break
+		}
+
+		p.offset++
+	}
+
+	return p.input[start:p.offset]
+}
+
+func (p *parser) durationUnit() (uint8, bool) {
+	word := p.word()
+
+	for i, unit := range durationUnits {
+		if unit.Symbol == word {
+			return uint8(i), true
+		}
+	}
+
+	i, ok := durationUnitAliases[word]
+	return i, ok
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,83 @@
+package go_pretty_print
+
+import (
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseDuration(t *testing.T) {
+	assertParseDuration(t, "0", 0)
+	assertParseDuration(t, "0s", 0)
+	assertParseDuration(t, "-0s", 0)
+
+	assertParseDuration(t, "8ns", ns8)
+	assertParseDuration(t, "7us 8ns", us7+ns8)
+	assertParseDuration(t, "7µs 8ns", us7+ns8)
+	assertParseDuration(t, "6ms 7us", ms6+us7)
+	assertParseDuration(t, "5s 7us", s5+us7)
+	assertParseDuration(t, "4m", m4)
+	assertParseDuration(t, "3h", h3)
+	assertParseDuration(t, "2d", d2)
+	assertParseDuration(t, "1w", w1)
+	assertParseDuration(t, "1w 2d 3h 4m 5s 6ms 7us 8ns", w1+d2+h3+m4+s5+ms6+us7+ns8)
+
+	assertParseDuration(t, "1w2d", w1+d2)
+	assertParseDuration(t, "  1 w   2 d  ", w1+d2)
+	assertParseDuration(t, "+1w", w1)
+	assertParseDuration(t, "90m", 90*time.Minute)
+
+	assertParseDuration(t, "-8ns", -ns8)
+	assertParseDuration(t, "-1w 2d", -w1-d2)
+
+	assertParseDuration(t, "15250w 1d 23h 47m 16s 854ms 775us 807ns", math.MaxInt64)
+	assertParseDuration(t, "-15250w 1d 23h 47m 16s 854ms 775us 808ns", math.MinInt64)
+}
+
+func assertParseDuration(t *testing.T, s string, expected time.Duration) {
+	t.Helper()
+
+	d, err := ParseDuration(s)
+	AssertCallResult(t, "ParseDuration(%#v)", []any{s}, []any{Duration(expected), nil}, []any{d, err})
+}
+
+func TestParseDuration_Error(t *testing.T) {
+	assertParseDuration_Error(t, "", 0, "empty duration")
+	assertParseDuration_Error(t, "  ", 2, "empty duration")
+	assertParseDuration_Error(t, "-", 1, "expected a number")
+	assertParseDuration_Error(t, "1", 1, "missing unit")
+	assertParseDuration_Error(t, "1w 2", 4, "missing unit")
+	assertParseDuration_Error(t, "1x", 1, "unknown unit")
+	assertParseDuration_Error(t, "1w 2dd", 4, "unknown unit")
+	assertParseDuration_Error(t, "w", 0, "expected a number")
+	assertParseDuration_Error(t, "1w -2d", 3, "expected a number")
+	assertParseDuration_Error(t, "1.5h", 1, "missing unit")
+	assertParseDuration_Error(t, "2d 1w", 4, "unit out of order")
+	assertParseDuration_Error(t, "1h 1h", 4, "unit out of order")
+	assertParseDuration_Error(t, "15250w 1d 23h 47m 16s 854ms 775us 808ns", 34, "duration out of range")
+	assertParseDuration_Error(t, "-15250w 1d 23h 47m 16s 854ms 775us 809ns", 35, "duration out of range")
+	assertParseDuration_Error(t, "99999999999999999999999ns", 0, "duration out of range")
+}
+
+func assertParseDuration_Error(t *testing.T, s string, offset int, msg string) {
+	t.Helper()
+
+	d, err := ParseDuration(s)
+	AssertCallResult(
+		t, "ParseDuration(%#v)", []any{s}, []any{Duration(0), &ParseError{s, offset, msg}}, []any{d, err},
+	)
+}
+
+func FuzzParseDuration(f *testing.F) {
+	for _, d := range [...]time.Duration{0, ns8, us7, ms6, s5, m4, h3, d2, w1, math.MaxInt64, math.MinInt64} {
+		f.Add(int64(d))
+		f.Add(int64(-d))
+	}
+
+	f.Fuzz(func(t *testing.T, d int64) {
+		s := Formatter{}.Format(Duration(d))
+		actual, err := ParseDuration(s)
+		AssertCallResult(t, "ParseDuration(%#v)", []any{s}, []any{Duration(d), nil}, []any{actual, err})
+	})
+}
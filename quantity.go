@@ -0,0 +1,465 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Number is an underlying type quantities printed with a UnitSystem may have.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Unit is something a quantity of type Q can be broken down into, e.g. Unit[Distance]{Symbol: "km", One: 1e6}.
+type Unit[Q Number] struct {
+	// Symbol identifies the unit in Locale.Units, e.g. "d".
+	Symbol string
+	One    Q
+	// Names are used by UnitSystem.LongSegments and, for DurationUnit, if the Locale doesn't know Symbol.
+	Names UnitNames
+}
+
+// UnitSystem prints quantities of type Q with a table of units, like Duration and ByteSize are printed.
+// It's immutable and safe for concurrent use.
+type UnitSystem[Q Number] struct {
+	// units are sorted largest first.
+	units []Unit[Q]
+	// zero is the unit zero is printed with.
+	zero Unit[Q]
+	// noFractions tells not to print fractions of the smallest unit.
+	noFractions bool
+}
+
+// NewUnitSystem returns a UnitSystem using the given units, printing zero with the one of 1 (or the smallest one).
+// Panics if there are no units or any of them isn't positive.
+func NewUnitSystem[Q Number](units ...Unit[Q]) UnitSystem[Q] {
+	if len(units) == 0 {
+		panic("go_pretty_print: a unit system needs units")
+	}
+
+	us := UnitSystem[Q]{units: append([]Unit[Q](nil), units...)}
+
+	for _, unit := range us.units {
+		if !(unit.One > 0) {
+			panic("go_pretty_print: unit " + unit.Symbol + " must be positive")
+		}
+	}
+
+	sort.SliceStable(us.units, func(i, j int) bool {
+		return us.units[i].One > us.units[j].One
+	})
+
+	return us.withUnits(us.units)
+}
+
+// withUnits returns a copy of us using units, which have to be sorted already, e.g. a part of us.units.
+func (us UnitSystem[Q]) withUnits(units []Unit[Q]) UnitSystem[Q] {
+	us.units = units
+	us.zero = units[len(units)-1]
+
+	for _, unit := range units {
+		if unit.One == 1 {
+			us.zero = unit
+			break
+		}
+	}
+
+	return us
+}
+
+// WithZeroUnit returns a copy of us printing zero with the given unit, e.g. "0s".
+func (us UnitSystem[Q]) WithZeroUnit(unit Unit[Q]) UnitSystem[Q] {
+	us.zero = unit
+	return us
+}
+
+// WithoutFractions returns a copy of us never printing fractions of the smallest unit, e.g. bytes.
+func (us UnitSystem[Q]) WithoutFractions() UnitSystem[Q] {
+	us.noFractions = true
+	return us
+}
+
+// Units returns the units of us, largest first.
+func (us UnitSystem[Q]) Units() []Unit[Q] {
+	return append([]Unit[Q](nil), us.units...)
+}
+
+// Of returns q printed with us.
+func (us UnitSystem[Q]) Of(q Q) Quantity[Q] {
+	return Quantity[Q]{q, us}
+}
+
+// Segments prints q as at most the given amount of segments (or all if segments < 1), e.g. "1km 500m".
+// What's below the last segment printed is truncated.
+func (us UnitSystem[Q]) Segments(q Q, segments int) string {
+	var buf [64]byte
+	return string(us.AppendSegments(buf[:0], q, segments))
+}
+
+// AppendSegments appends q like Segments to dst.
+func (us UnitSystem[Q]) AppendSegments(dst []byte, q Q, segments int) []byte {
+	if isFloat[Q]() {
+		return us.appendFloatSegments(dst, float64(q), segments, appendFloatSymbol[Q])
+	}
+
+	negative, abs := absUint(q)
+	return us.appendSegments(dst, negative, abs, segments, appendSpace, appendSymbol[Q])
+}
+
+// LongSegments is like Segments, but spells the units out with their Names.Long in English plurals,
+// e.g. "1 kilometer 500 meters". Units without such Names are printed like by Segments.
+func (us UnitSystem[Q]) LongSegments(q Q, segments int) string {
+	var buf [128]byte
+	return string(us.AppendLongSegments(buf[:0], q, segments))
+}
+
+// AppendLongSegments appends q like LongSegments to dst.
+func (us UnitSystem[Q]) AppendLongSegments(dst []byte, q Q, segments int) []byte {
+	if isFloat[Q]() {
+		return us.appendFloatSegments(dst, float64(q), segments, appendFloatName[Q])
+	}
+
+	negative, abs := absUint(q)
+	return us.appendSegments(dst, negative, abs, segments, appendSpace, appendName[Q])
+}
+
+// appendSegments appends at most segments (or all if segments < 1) non-zero segments of the integer magnitude abs
+// via appendUnit, the i-th of n ones preceded by appendSeparator (except the first). Zero is appended via appendUnit
+// with us.zero. Rounding, localization etc. are up to the callers, e.g. Formatter.
+func (us UnitSystem[Q]) appendSegments(
+	dst []byte, negative bool, abs uint64, segments int,
+	appendSeparator func(dst []byte, i, n int) []byte, appendUnit func(dst []byte, unit Unit[Q], amount uint64) []byte,
+) []byte {
+	n := us.countSegments(abs, segments)
+	if n == 0 {
+		return appendUnit(dst, us.zero, 0)
+	}
+
+	if negative {
+		dst = append(dst, '-')
+	}
+
+	for i, written := 0, 0; written < n; i++ {
+		one := uint64(us.units[i].One)
+		amount := abs / one
+		abs %= one
+
+		if amount > 0 {
+			if written > 0 {
+				dst = appendSeparator(dst, written, n)
+			}
+
+			dst = appendUnit(dst, us.units[i], amount)
+			written++
+		}
+	}
+
+	return dst
+}
+
+// countSegments returns the amount of non-zero segments of abs, but at most segments (or all if segments < 1).
+func (us UnitSystem[Q]) countSegments(abs uint64, segments int) int {
+	if segments < 1 {
+		segments = len(us.units)
+	}
+
+	n := 0
+	for i := 0; i < len(us.units) && n < segments; i++ {
+		if abs >= uint64(us.units[i].One) {
+			n++
+		}
+
+		abs %= uint64(us.units[i].One)
+	}
+
+	return n
+}
+
+// round rounds the integer magnitude abs to the last of at most segments (or all if segments < 1) segments.
+func (us UnitSystem[Q]) round(abs uint64, negative bool, segments int, rounding Rounding) uint64 {
+	if rounding == Truncate {
+		return abs
+	}
+
+	if segments < 1 {
+		segments = len(us.units)
+	}
+
+	rest := abs
+
+	for i, unit := range us.units {
+		one := uint64(unit.One)
+
+		if rest >= one {
+			segments--
+		}
+
+		if segments == 0 || i == len(us.units)-1 {
+			rem := rest % one
+			if rem == 0 {
+				break
+			}
+
+			var up bool
+			switch rounding {
+			case Floor:
+				up = negative
+			case Ceiling:
+				up = !negative
+			case HalfUp:
+				up = rem >= one-rem
+			case HalfEven:
+				up = rem > one-rem || rem == one-rem && rest/one%2 == 1
+			}
+
+			if up {
+				abs += one - rem
+			}
+
+			break
+		}
+
+		rest %= one
+	}
+
+	return abs
+}
+
+func appendSpace(dst []byte, _, _ int) []byte {
+	return append(dst, ' ')
+}
+
+func appendSymbol[Q Number](dst []byte, unit Unit[Q], amount uint64) []byte {
+	return append(strconv.AppendUint(dst, amount, 10), unit.Symbol...)
+}
+
+func appendName[Q Number](dst []byte, unit Unit[Q], amount uint64) []byte {
+	return localeEnglish.appendNames(dst, &unit.Names, unit.Symbol, amount, Long)
+}
+
+func appendFloatSymbol[Q Number](dst []byte, unit Unit[Q], amount float64) []byte {
+	return append(strconv.AppendFloat(dst, amount, 'f', 0, 64), unit.Symbol...)
+}
+
+func appendFloatName[Q Number](dst []byte, unit Unit[Q], amount float64) []byte {
+	if amount < 1<<64 {
+		return appendName(dst, unit, uint64(amount))
+	}
+
+	// Such amounts are PluralOther anyway.
+	pattern := unit.Names.pattern(Long, PluralOther)
+	if i := strings.Index(pattern, "{0}"); i >= 0 {
+		dst = append(dst, pattern[:i]...)
+		dst = strconv.AppendFloat(dst, amount, 'f', 0, 64)
+		return append(dst, pattern[i+3:]...)
+	}
+
+	return appendFloatSymbol(dst, unit, amount)
+}
+
+// appendFloatSegments is like appendSegments for floats, rounding and sign included.
+func (us UnitSystem[Q]) appendFloatSegments(
+	dst []byte, q float64, segments int, appendUnit func(dst []byte, unit Unit[Q], amount float64) []byte,
+) []byte {
+	if math.IsInf(q, 0) || math.IsNaN(q) {
+		return append(strconv.AppendFloat(dst, q, 'g', -1, 64), us.zero.Symbol...)
+	}
+
+	abs := math.Abs(q)
+	if segments < 1 {
+		segments = len(us.units)
+	}
+
+	start := len(dst)
+	if q < 0 {
+		dst = append(dst, '-')
+	}
+
+	first := true
+
+	for _, unit := range us.units {
+		if segments == 0 {
+			break
+		}
+
+		one := float64(unit.One)
+
+		// Tolerate float errors like 0.3/0.1 = 2.9999999999999996.
+		if amount := math.Floor(abs/one + 1e-9); amount > 0 {
+			if !first {
+				dst = append(dst, ' ')
+			}
+
+			dst = appendUnit(dst, unit, amount)
+			abs = math.Max(abs-amount*one, 0)
+			first = false
+			segments--
+		}
+	}
+
+	if first {
+		return appendUnit(dst[:start], us.zero, 0)
+	}
+
+	return dst
+}
+
+// Scaled prints q in the largest unit it fills with the given significant digits (at least 1),
+// e.g. "1.50km". The integer part is never cut.
+func (us UnitSystem[Q]) Scaled(q Q, digits int) string {
+	var buf [32]byte
+	return string(us.AppendScaled(buf[:0], q, digits))
+}
+
+// AppendScaled appends q like Scaled to dst.
+func (us UnitSystem[Q]) AppendScaled(dst []byte, q Q, digits int) []byte {
+	return appendScaled(dst, us.units, us.zero.Symbol, us.noFractions, float64(q), digits)
+}
+
+// appendScaled appends q like UnitSystem.AppendScaled with units sorted largest first.
+// Unlike NewUnitSystem it doesn't validate them, so Rate.Units can be used as they are.
+func appendScaled[Q Number](dst []byte, units []Unit[Q], zero string, noFractions bool, q float64, digits int) []byte {
+	if math.IsInf(q, 0) || math.IsNaN(q) {
+		return append(strconv.AppendFloat(dst, q, 'g', -1, 64), zero...)
+	}
+
+	abs := math.Abs(q)
+	if abs == 0 {
+		return append(append(dst, '0'), zero...)
+	}
+
+	if digits < 1 {
+		digits = 1
+	}
+
+	// Invalid units (not positive) are skipped, at worst the amount is printed as is.
+	i := 0
+	for i < len(units)-1 && !(units[i].One > 0 && abs >= float64(units[i].One)) {
+		i++
+	}
+
+	value := abs / float64(units[i].One)
+	if !(units[i].One > 0) || math.IsInf(value, 0) {
+		return append(strconv.AppendFloat(dst, q, 'g', -1, 64), units[i].Symbol...)
+	}
+
+	decimals := digits - intDigits(value)
+
+	if decimals < 0 {
+		decimals = 0
+	}
+
+	// Rounding may carry into the next unit (59.999s -> 1.00m) or the next integer digit (9.999ms -> 10.0ms).
+	if i > 0 && units[i-1].One > 0 && value >= float64(units[i-1].One)/float64(units[i].One)-halfStep(decimals) {
+		i--
+		value = abs / float64(units[i].One)
+		decimals = digits - 1
+	} else if decimals > 0 && value >= math.Pow10(intDigits(value))-halfStep(decimals) {
+		decimals--
+	}
+
+	if noFractions && i == len(units)-1 {
+		decimals = 0
+	}
+
+	if q < 0 {
+		dst = append(dst, '-')
+	}
+
+	dst = strconv.AppendFloat(dst, value, 'f', decimals, 64)
+	return append(dst, units[i].Symbol...)
+}
+
+// format prints q for the verbs Quantity.Format supports except %#v. It returns false for any other verb.
+func (us UnitSystem[Q]) format(f fmt.State, c rune, q Q) bool {
+	switch c {
+	case 'b', 'e', 'E', 'f', 'g', 'G':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = -1
+		}
+
+		writePadded(f, strconv.FormatFloat(float64(q), byte(c), prec, 64), "", f.Flag('0'))
+	case 's', 'v':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = 1
+		}
+
+		var buf [64]byte
+		if c == 's' && f.Flag('#') {
+			writePadded(f, string(us.AppendLongSegments(buf[:0], q, prec+1)), "", f.Flag('0'))
+		} else {
+			writePadded(f, string(us.AppendSegments(buf[:0], q, prec+1)), "", f.Flag('0'))
+		}
+	case 'u':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = 3
+		}
+
+		var buf [32]byte
+		writePadded(f, string(us.AppendScaled(buf[:0], q, prec)), "", f.Flag('0'))
+	case 'd', 'o', 'O', 'x', 'X':
+		if isFloat[Q]() {
+			return false
+		}
+
+		negative, abs := absUint(q)
+		writeInt(f, c, negative, abs)
+	default:
+		return false
+	}
+
+	return true
+}
+
+// Quantity is a value printed with a UnitSystem by String and Format.
+type Quantity[Q Number] struct {
+	Value  Q
+	System UnitSystem[Q]
+}
+
+// String prints at most two segments, e.g. "1km 500m".
+func (q Quantity[Q]) String() string {
+	return q.System.Segments(q.Value, 2)
+}
+
+// Format works like Duration.Format, i.e. supports these verbs:
+//
+//	%s, %v                    segments like String, the precision is the amount of additional segments
+//	%#s                       like %s, but with the units spelled out like LongSegments
+//	%u                        like Scaled, the precision is the amount of significant digits (default 3)
+//	%b, %e, %E, %f, %g, %G    float Value
+//	%d, %o, %O, %x, %X        integer Value (unless it's a float)
+//	%#v                       Value in Go syntax
+func (q Quantity[Q]) Format(f fmt.State, c rune) {
+	if c == 'v' && f.Flag('#') {
+		writePadded(f, fmt.Sprintf("%#v", q.Value), "", false)
+		return
+	}
+
+	if !q.System.format(f, c, q.Value) {
+		fmt.Fprintf(f, "%%!%c(go_pretty_print.Quantity=%s)", c, q.String())
+	}
+}
+
+// isFloat tells whether Q is a floating point type.
+func isFloat[Q Number]() bool {
+	var one Q = 1
+	return one/2 != 0
+}
+
+// absUint returns the magnitude of the integer q as uint64, which (unlike -q) also works for math.MinInt64.
+func absUint[Q Number](q Q) (negative bool, abs uint64) {
+	if q < 0 {
+		return true, uint64(-(q + 1)) + 1
+	}
+
+	return false, uint64(q)
+}
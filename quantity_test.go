@@ -0,0 +1,164 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+)
+
+// distance is in millimeters.
+type distance int64
+
+var distanceSystem = NewUnitSystem(
+	Unit[distance]{Symbol: "mm", One: 1}, Unit[distance]{Symbol: "km", One: 1e6},
+	Unit[distance]{Symbol: "cm", One: 10}, Unit[distance]{Symbol: "m", One: 1e3},
+)
+
+var namedDistanceSystem = NewUnitSystem(
+	Unit[distance]{Symbol: "km", One: 1e6, Names: UnitNames{Long: oneOther("{0} kilometer", "{0} kilometers")}},
+	Unit[distance]{Symbol: "m", One: 1e3, Names: UnitNames{Long: oneOther("{0} meter", "{0} meters")}},
+	Unit[distance]{Symbol: "mm", One: 1},
+)
+
+// energy is in joules.
+type energy float64
+
+var energySystem = NewUnitSystem(
+	Unit[energy]{Symbol: "MJ", One: 1e6}, Unit[energy]{Symbol: "kJ", One: 1e3}, Unit[energy]{Symbol: "J", One: 1},
+)
+
+// cents are a currency's minor units.
+type cents uint32
+
+var centSystem = NewUnitSystem(Unit[cents]{Symbol: "€", One: 100}, Unit[cents]{Symbol: "ct", One: 1}).WithoutFractions()
+
+func TestNewUnitSystem(t *testing.T) {
+	AssertCallResult(
+		t, "distanceSystem.Units()", nil,
+		[]any{[]Unit[distance]{
+			{Symbol: "km", One: 1e6}, {Symbol: "m", One: 1e3}, {Symbol: "cm", One: 10}, {Symbol: "mm", One: 1},
+		}},
+		[]any{distanceSystem.Units()},
+	)
+
+	assertNewUnitSystem_Panic(t, func() { NewUnitSystem[distance]() }, "go_pretty_print: a unit system needs units")
+	assertNewUnitSystem_Panic(
+		t, func() { NewUnitSystem(Unit[distance]{Symbol: "x", One: 0}) }, "go_pretty_print: unit x must be positive",
+	)
+	assertNewUnitSystem_Panic(
+		t, func() { NewUnitSystem(Unit[energy]{Symbol: "x", One: energy(math.NaN())}) }, "go_pretty_print: unit x must be positive",
+	)
+}
+
+func assertNewUnitSystem_Panic(t *testing.T, f func(), expected string) {
+	t.Helper()
+
+	defer func() {
+		t.Helper()
+		AssertCallResult(t, "NewUnitSystem()", nil, []any{expected}, []any{recover()})
+	}()
+
+	f()
+}
+
+func TestUnitSystem_Segments(t *testing.T) {
+	assertUnitSystem_Segments(t, distanceSystem, 0, 0, "0mm")
+	assertUnitSystem_Segments(t, distanceSystem, 1500, 0, "1m 50cm")
+	assertUnitSystem_Segments(t, distanceSystem, 42195e3+7, 2, "42km 195m")
+	assertUnitSystem_Segments(t, distanceSystem, 42195e3+7, 0, "42km 195m 7mm")
+	assertUnitSystem_Segments(t, distanceSystem, -1005, 1, "-1m")
+	assertUnitSystem_Segments(t, distanceSystem, math.MinInt64, 1, "-9223372036854km")
+	assertUnitSystem_Segments(t, distanceSystem.WithZeroUnit(Unit[distance]{Symbol: "m", One: 1e3}), 0, 0, "0m")
+
+	assertUnitSystem_Segments(t, energySystem, 0, 0, "0J")
+	assertUnitSystem_Segments(t, energySystem, 2.5e6, 0, "2MJ 500kJ")
+	assertUnitSystem_Segments(t, energySystem, 0.3e3, 0, "300J")
+	assertUnitSystem_Segments(t, energySystem, -1234.5, 0, "-1kJ 234J")
+	assertUnitSystem_Segments(t, energySystem, 0.5, 0, "0J")
+	assertUnitSystem_Segments(t, energySystem, energy(math.Inf(1)), 0, "+InfJ")
+
+	assertUnitSystem_Segments(t, centSystem, 1999, 0, "19€ 99ct")
+	assertUnitSystem_Segments(t, centSystem, math.MaxUint32, 0, "42949672€ 95ct")
+}
+
+func TestUnitSystem_LongSegments(t *testing.T) {
+	assertUnitSystem_LongSegments(t, namedDistanceSystem, 0, 0, "0mm")
+	assertUnitSystem_LongSegments(t, namedDistanceSystem, 1e6+2e3, 0, "1 kilometer 2 meters")
+	assertUnitSystem_LongSegments(t, namedDistanceSystem, -1e3-7, 0, "-1 meter 7mm")
+	assertUnitSystem_LongSegments(t, namedDistanceSystem, 42195e3+7, 1, "42 kilometers")
+	assertUnitSystem_LongSegments(t, namedDistanceSystem.WithZeroUnit(namedDistanceSystem.Units()[1]), 0, 0, "0 meters")
+	assertUnitSystem_LongSegments(t, distanceSystem, 1500, 0, "1m 50cm")
+
+	named := NewUnitSystem(Unit[energy]{Symbol: "J", One: 1, Names: UnitNames{Long: oneOther("{0} joule", "{0} joules")}})
+	assertUnitSystem_LongSegments(t, named, 1, 0, "1 joule")
+	assertUnitSystem_LongSegments(t, named, 0.5, 0, "0 joules")
+	assertUnitSystem_LongSegments(t, named, 1e20, 0, "100000000000000000000 joules")
+}
+
+func assertUnitSystem_LongSegments[Q Number](t *testing.T, us UnitSystem[Q], q Q, segments int, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "UnitSystem.LongSegments(%v, %d)", []any{float64(q), segments}, []any{expected}, []any{us.LongSegments(q, segments)},
+	)
+}
+
+func assertUnitSystem_Segments[Q Number](t *testing.T, us UnitSystem[Q], q Q, segments int, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "UnitSystem.Segments(%v, %d)", []any{float64(q), segments}, []any{expected}, []any{us.Segments(q, segments)})
+}
+
+func TestUnitSystem_Scaled(t *testing.T) {
+	assertUnitSystem_Scaled(t, distanceSystem, 0, 3, "0mm")
+	assertUnitSystem_Scaled(t, distanceSystem, 7, 3, "7.00mm")
+	assertUnitSystem_Scaled(t, distanceSystem, 1500, 3, "1.50m")
+	assertUnitSystem_Scaled(t, distanceSystem, 999999, 3, "1.00km")
+	assertUnitSystem_Scaled(t, distanceSystem, -42195e3, 0, "-42km")
+
+	assertUnitSystem_Scaled(t, energySystem, 2.5e6, 2, "2.5MJ")
+	assertUnitSystem_Scaled(t, energySystem, 0.25, 3, "0.250J")
+	assertUnitSystem_Scaled(t, energySystem, energy(math.NaN()), 3, "NaNJ")
+
+	assertUnitSystem_Scaled(t, centSystem, 1999, 3, "20.0€")
+	assertUnitSystem_Scaled(t, centSystem, 42, 3, "42ct")
+}
+
+func assertUnitSystem_Scaled[Q Number](t *testing.T, us UnitSystem[Q], q Q, digits int, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "UnitSystem.Scaled(%v, %d)", []any{float64(q), digits}, []any{expected}, []any{us.Scaled(q, digits)})
+}
+
+func TestQuantity_Format(t *testing.T) {
+	d := distanceSystem.Of(42195e3 + 7)
+
+	AssertCallResult(t, "distanceSystem.Of(%d).String()", []any{42195e3 + 7}, []any{"42km 195m"}, []any{d.String()})
+	assertQuantity_Format(t, d, "%v", "42km 195m")
+	assertQuantity_Format(t, d, "%.0s", "42km")
+	assertQuantity_Format(t, d, "%.2s", "42km 195m 7mm")
+	assertQuantity_Format(t, d, "%12s|", "   42km 195m|")
+	assertQuantity_Format(t, namedDistanceSystem.Of(42195e3+7), "%#s", "42 kilometers 195 meters")
+	assertQuantity_Format(t, namedDistanceSystem.Of(42195e3+7), "%#.2s", "42 kilometers 195 meters 7mm")
+	assertQuantity_Format(t, d, "%u", "42.2km")
+	assertQuantity_Format(t, d, "%.5u", "42.195km")
+	assertQuantity_Format(t, d, "%d", "42195007")
+	assertQuantity_Format(t, d, "%x", "283d83f")
+	assertQuantity_Format(t, d, "%e", "4.2195007e+07")
+	assertQuantity_Format(t, d, "%#v", "42195007")
+	assertQuantity_Format(t, d, "%q", "%!q(go_pretty_print.Quantity=42km 195m)")
+
+	e := energySystem.Of(2.5e6)
+
+	assertQuantity_Format(t, e, "%v", "2MJ 500kJ")
+	assertQuantity_Format(t, e, "%+u", "+2.50MJ")
+	assertQuantity_Format(t, e, "%.1f", "2500000.0")
+	assertQuantity_Format(t, e, "%d", "%!d(go_pretty_print.Quantity=2MJ 500kJ)")
+}
+
+func assertQuantity_Format[Q Number](t *testing.T, q Quantity[Q], format, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "fmt.Sprintf(%#v, Quantity{%v})", []any{format, float64(q.Value)}, []any{expected}, []any{fmt.Sprintf(format, q)})
+}
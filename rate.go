@@ -0,0 +1,227 @@
+package go_pretty_print
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+// RateUnit scales the amount of a Rate, e.g. "MiB" for 1<<20 bytes.
+type RateUnit = Unit[float64]
+
+var (
+	// ByteRateUnits are binary ones like ByteSize uses.
+	ByteRateUnits = rateUnitsOf(&byteUnitsIEC)
+	// ByteRateUnitsSI are decimal ones like SIByteSize uses.
+	ByteRateUnitsSI = rateUnitsOf(&byteUnitsSI)
+)
+
+func rateUnitsOf(units *[7]ByteUnit) []RateUnit {
+	rateUnits := make([]RateUnit, 0, len(units))
+	for _, unit := range units {
+		rateUnits = append(rateUnits, RateUnit{Symbol: unit.Symbol, One: float64(unit.One)})
+	}
+
+	return rateUnits
+}
+
+// CountRateUnits returns SI-prefixed units for counting symbol, e.g. "4.5k req" for " req".
+func CountRateUnits(symbol string) []RateUnit {
+	return []RateUnit{
+		{Symbol: "T" + symbol, One: 1e12}, {Symbol: "G" + symbol, One: 1e9}, {Symbol: "M" + symbol, One: 1e6},
+		{Symbol: "k" + symbol, One: 1e3}, {Symbol: symbol, One: 1},
+	}
+}
+
+var (
+	RateSecond = DurationUnit{Symbol: "s", One: Duration(time.Second)}
+	RateMinute = DurationUnit{Symbol: "min", One: Duration(time.Minute)}
+	RateHour   = DurationUnit{Symbol: "h", One: Duration(time.Hour)}
+)
+
+// Rate is an amount of something per time printed like "12.3MiB/s" or "4.50k req/min".
+type Rate struct {
+	// PerSecond is the amount per second.
+	PerSecond float64
+	// Units scale the amount, largest first. Without any, it's printed as is.
+	Units []RateUnit
+	// Per is the time unit to print, RateSecond, RateMinute or RateHour if zero,
+	// whichever is the first one with an amount of at least 1 (or RateSecond for zero rates).
+	Per DurationUnit
+}
+
+// NewRate returns amount per d, scaled with units.
+func NewRate(amount float64, d Duration, units []RateUnit) Rate {
+	return Rate{PerSecond: amount / (float64(d) / float64(time.Second)), Units: units}
+}
+
+// ByteRate returns size per d with binary units.
+func ByteRate(size ByteSize, d Duration) Rate {
+	return NewRate(float64(size), d, ByteRateUnits)
+}
+
+// CountRate returns n symbols (e.g. " req") per d with SI-prefixed units.
+func CountRate(n float64, d Duration, symbol string) Rate {
+	return NewRate(n, d, CountRateUnits(symbol))
+}
+
+// String prints r with 3 significant digits.
+func (r Rate) String() string {
+	return r.scaled(3)
+}
+
+// Format supports these verbs:
+//
+//	%s, %v, %u                like String, the precision is the amount of significant digits
+//	%b, %e, %E, %f, %g, %G    float PerSecond
+//	%#v                       like GoString
+func (r Rate) Format(f fmt.State, c rune) {
+	if c == 'v' && f.Flag('#') {
+		writePadded(f, r.GoString(), "", false)
+		return
+	}
+
+	switch c {
+	case 'b', 'e', 'E', 'f', 'g', 'G':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = -1
+		}
+
+		writePadded(f, strconv.FormatFloat(r.PerSecond, byte(c), prec, 64), "", f.Flag('0'))
+	case 's', 'v', 'u':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = 3
+		}
+
+		writePadded(f, r.scaled(prec), "", f.Flag('0'))
+	default:
+		fmt.Fprintf(f, "%%!%c(go_pretty_print.Rate=%s)", c, r.scaled(3))
+	}
+}
+
+// GoString prints r as Go expression, e.g.
+// "go_pretty_print.Rate{PerSecond: 5, Units: go_pretty_print.ByteRateUnits, Per: go_pretty_print.RateMinute}".
+func (r Rate) GoString() string {
+	var perSecond string
+	switch {
+	case math.IsNaN(r.PerSecond):
+		perSecond = "math.NaN()"
+	case math.IsInf(r.PerSecond, 1):
+		perSecond = "math.Inf(1)"
+	case math.IsInf(r.PerSecond, -1):
+		perSecond = "math.Inf(-1)"
+	default:
+		perSecond = strconv.FormatFloat(r.PerSecond, 'g', -1, 64)
+	}
+
+	s := "go_pretty_print.Rate{PerSecond: " + perSecond
+
+	if r.Units != nil {
+		s += ", Units: " + rateUnitsGoString(r.Units)
+	}
+
+	if r.Per != (DurationUnit{}) {
+		s += ", Per: " + ratePerGoString(r.Per)
+	}
+
+	return s + "}"
+}
+
+// rateUnitsGoString prints units as Go expression, preferably one of the predefined ones.
+func rateUnitsGoString(units []RateUnit) string {
+	switch {
+	case equalRateUnits(units, ByteRateUnits):
+		return "go_pretty_print.ByteRateUnits"
+	case equalRateUnits(units, ByteRateUnitsSI):
+		return "go_pretty_print.ByteRateUnitsSI"
+	case len(units) > 0 && equalRateUnits(units, CountRateUnits(units[len(units)-1].Symbol)):
+		return "go_pretty_print.CountRateUnits(" + strconv.Quote(units[len(units)-1].Symbol) + ")"
+	}
+
+	s := "[]go_pretty_print.RateUnit{"
+	for i, unit := range units {
+		if i > 0 {
+			s += ", "
+		}
+
+		s += "{Symbol: " + strconv.Quote(unit.Symbol) + ", One: " + strconv.FormatFloat(unit.One, 'g', -1, 64) + "}"
+	}
+
+	return s + "}"
+}
+
+func equalRateUnits(a, b []RateUnit) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+var ratePersGo = [...]struct {
+	unit   DurationUnit
+	goName string
+}{
+	{RateSecond, "RateSecond"}, {RateMinute, "RateMinute"}, {RateHour, "RateHour"},
+	{UnitDay, "UnitDay"}, {UnitWeek, "UnitWeek"},
+}
+
+// ratePerGoString prints per as Go expression, preferably one of the predefined units.
+func ratePerGoString(per DurationUnit) string {
+	for _, known := range ratePersGo {
+		if known.unit == per {
+			return "go_pretty_print." + known.goName
+		}
+	}
+
+	return "go_pretty_print.DurationUnit{Symbol: " + strconv.Quote(per.Symbol) + ", One: " + per.One.GoString() + "}"
+}
+
+func (r Rate) scaled(digits int) string {
+	per := r.Per
+	if per.One == 0 {
+		per = RateHour
+
+		for _, unit := range [2]DurationUnit{RateSecond, RateMinute} {
+			if r.PerSecond == 0 || math.Abs(r.PerSecond)*(float64(unit.One)/float64(time.Second)) >= 1 {
+				per = unit
+				break
+			}
+		}
+	}
+
+	amount := r.PerSecond * (float64(per.One) / float64(time.Second))
+	if math.IsInf(amount, 0) || math.IsNaN(amount) {
+		return strconv.FormatFloat(amount, 'g', -1, 64) + "/" + per.Symbol
+	}
+
+	units := r.Units
+	if len(units) == 0 {
+		units = []RateUnit{{Symbol: "", One: 1}}
+	}
+
+	var buf [32]byte
+	return string(append(appendScaled(buf[:0], units, units[len(units)-1].Symbol, false, amount, digits), "/"+per.Symbol...))
+}
+
+// MarshalJSON writes the float amount per second like Duration.MarshalJSON writes seconds.
+func (r Rate) MarshalJSON() ([]byte, error) {
+	return strconv.AppendFloat(nil, r.PerSecond, 'g', -1, 64), nil
+}
+
+// UnmarshalJSON accepts numbers per second (as written by MarshalJSON) and keeps r's Units and Per.
+func (r *Rate) UnmarshalJSON(data []byte) error {
+	return unmarshalJSONValue(data, r, func(s string) (Rate, error) {
+		perSecond, err := strconv.ParseFloat(s, 64)
+		return Rate{perSecond, r.Units, r.Per}, err
+	}, nil)
+}
@@ -0,0 +1,131 @@
+package go_pretty_print
+
+import (
+	"encoding/json"
+	"fmt"
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestRate_String(t *testing.T) {
+	assertRate_String(t, ByteRate(12*mib+300*kib, Duration(time.Second)), "12.3MiB/s")
+	assertRate_String(t, ByteRate(12*mib+300*kib, Duration(2*time.Second)), "6.15MiB/s")
+	assertRate_String(t, ByteRate(1536, Duration(time.Minute)), "25.6B/s")
+	assertRate_String(t, ByteRate(30, Duration(time.Minute)), "30.0B/min")
+	assertRate_String(t, ByteRate(30, Duration(time.Hour)), "30.0B/h")
+	assertRate_String(t, ByteRate(1, Duration(d2)), "0.0208B/h")
+	assertRate_String(t, ByteRate(1023*kib+1023, Duration(time.Second)), "1.00MiB/s")
+	assertRate_String(t, ByteRate(-3*gib, Duration(time.Second)), "-3.00GiB/s")
+	assertRate_String(t, ByteRate(0, Duration(time.Second)), "0B/s")
+	assertRate_String(t, ByteRate(3e9, Duration(time.Second)), "2.79GiB/s")
+	assertRate_String(t, NewRate(3e9, Duration(time.Second), ByteRateUnitsSI), "3.00GB/s")
+
+	assertRate_String(t, CountRate(270000, Duration(time.Hour), " req"), "75.0 req/s")
+	assertRate_String(t, CountRate(4500, Duration(time.Minute), " req"), "75.0 req/s")
+	assertRate_String(t, CountRate(1e7, Duration(time.Second), " req"), "10.0M req/s")
+	assertRate_String(t, CountRate(9999, Duration(time.Second), " req"), "10.0k req/s")
+	assertRate_String(t, CountRate(30, Duration(time.Minute), " req"), "30.0 req/min")
+	assertRate_String(t, CountRate(42, Duration(time.Second), ""), "42.0/s")
+	assertRate_String(t, NewRate(42, Duration(time.Second), nil), "42.0/s")
+	assertRate_String(t, NewRate(1234567, Duration(time.Second), nil), "1234567/s")
+	assertRate_String(t, NewRate(1, 0, nil), "+Inf/s")
+
+	invalid := []RateUnit{{Symbol: "x", One: 0}, {Symbol: "y", One: -1}, {Symbol: "z", One: 1}}
+	assertRate_String(t, Rate{PerSecond: 42, Units: invalid}, "42.0z/s")
+	assertRate_String(t, Rate{PerSecond: 42, Units: invalid[:1]}, "42x/s")
+	assertRate_String(t, Rate{Units: invalid[:1]}, "0x/s")
+
+	perMinute := CountRate(270000, Duration(time.Hour), " req")
+	perMinute.Per = RateMinute
+	assertRate_String(t, perMinute, "4.50k req/min")
+
+	perDay := ByteRate(gib, Duration(time.Hour))
+	perDay.Per = UnitDay
+	assertRate_String(t, perDay, "24.0GiB/d")
+}
+
+func assertRate_String(t *testing.T, r Rate, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Rate{%v}.String()", []any{r.PerSecond}, []any{expected}, []any{r.String()})
+}
+
+func TestRate_Format(t *testing.T) {
+	r := ByteRate(12*mib+300*kib, Duration(time.Second))
+
+	assertRate_Format(t, r, "%v", "12.3MiB/s")
+	assertRate_Format(t, r, "%s", "12.3MiB/s")
+	assertRate_Format(t, r, "%u", "12.3MiB/s")
+	assertRate_Format(t, r, "%.2v", "12MiB/s")
+	assertRate_Format(t, r, "%.5s", "12.293MiB/s")
+	assertRate_Format(t, r, "%12s|", "   12.3MiB/s|")
+	assertRate_Format(t, r, "%-12s|", "12.3MiB/s   |")
+	assertRate_Format(t, r, "%+v", "+12.3MiB/s")
+	assertRate_Format(t, r, "%012s", "00012.3MiB/s")
+	assertRate_Format(t, r, "%-012s|", "12.3MiB/s   |")
+	assertRate_Format(t, r, "%.1f", "12890112.0")
+	assertRate_Format(t, r, "%g", "1.2890112e+07")
+	assertRate_Format(t, r, "%d", "%!d(go_pretty_print.Rate=12.3MiB/s)")
+	assertRate_Format(t, r, "%#v", "go_pretty_print.Rate{PerSecond: 1.2890112e+07, Units: go_pretty_print.ByteRateUnits}")
+}
+
+func TestRate_GoString(t *testing.T) {
+	assertRate_GoString(t, Rate{PerSecond: 5}, "go_pretty_print.Rate{PerSecond: 5}")
+	assertRate_GoString(t, Rate{PerSecond: math.Inf(-1)}, "go_pretty_print.Rate{PerSecond: math.Inf(-1)}")
+
+	assertRate_GoString(
+		t, Rate{PerSecond: 3e9, Units: ByteRateUnitsSI, Per: RateMinute},
+		"go_pretty_print.Rate{PerSecond: 3e+09, Units: go_pretty_print.ByteRateUnitsSI, Per: go_pretty_print.RateMinute}",
+	)
+
+	assertRate_GoString(
+		t, Rate{PerSecond: 75, Units: CountRateUnits(" req"), Per: UnitDay},
+		`go_pretty_print.Rate{PerSecond: 75, Units: go_pretty_print.CountRateUnits(" req"), Per: go_pretty_print.UnitDay}`,
+	)
+
+	assertRate_GoString(
+		t, Rate{PerSecond: 0.5, Units: []RateUnit{{Symbol: "x", One: 1.5}}, Per: DurationUnit{Symbol: "fn", One: 14 * 24 * Duration(time.Hour)}},
+		`go_pretty_print.Rate{PerSecond: 0.5, Units: []go_pretty_print.RateUnit{{Symbol: "x", One: 1.5}}, `+
+			`Per: go_pretty_print.DurationUnit{Symbol: "fn", One: go_pretty_print.Duration(2*7*24*time.Hour)}}`,
+	)
+}
+
+func assertRate_GoString(t *testing.T, r Rate, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Rate{%v}.GoString()", []any{r.PerSecond}, []any{expected}, []any{r.GoString()})
+}
+
+func assertRate_Format(t *testing.T, r Rate, format, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "fmt.Sprintf(%#v, Rate{%v})", []any{format, r.PerSecond}, []any{expected}, []any{fmt.Sprintf(format, r)})
+}
+
+func TestRate_JSON(t *testing.T) {
+	type stats struct {
+		Throughput Rate `json:"throughput"`
+	}
+
+	jsn, err := json.Marshal(stats{ByteRate(3*mib, Duration(2*time.Second))})
+	AssertCallResult(t, "json.Marshal(%v)", []any{1.5 * mib}, []any{`{"throughput":1.572864e+06}`, nil}, []any{string(jsn), err})
+
+	s := stats{Rate{Units: ByteRateUnits}}
+	err = json.Unmarshal([]byte(`{"throughput":1572864}`), &s)
+	AssertCallResult(
+		t, "json.Unmarshal(%#v)", []any{`{"throughput":1572864}`}, []any{"1.50MiB/s", nil},
+		[]any{s.Throughput.String(), err},
+	)
+
+	err = json.Unmarshal([]byte(`{"throughput":null}`), &s)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"throughput":null}`}, []any{1.5 * mib, nil}, []any{s.Throughput.PerSecond, err})
+
+	_, isTypeError := json.Unmarshal([]byte(`{"throughput":"1MiB/s"}`), &s).(*json.UnmarshalTypeError)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{`{"throughput":"1MiB/s"}`}, []any{true}, []any{isTypeError})
+
+	_, err = json.Marshal(NewRate(1, 0, nil))
+	AssertCallResult(t, "json.Marshal(NewRate(1, 0, nil)) != nil", nil, []any{true}, []any{err != nil})
+	AssertCallResult(t, "math.IsInf(NewRate(1, 0, nil).PerSecond, 1)", nil, []any{true}, []any{math.IsInf(NewRate(1, 0, nil).PerSecond, 1)})
+}
@@ -0,0 +1,113 @@
+package go_pretty_print
+
+import (
+	"math"
+	"strings"
+	"time"
+)
+
+// Clock tells the current time, e.g. for tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc adapts a function to Clock.
+type ClockFunc func() time.Time
+
+func (cf ClockFunc) Now() time.Time {
+	return cf()
+}
+
+// SystemClock is the Clock of time.Now.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// RelativeFormatter prints points in time relative to now like "in 3 hours" or "3 hours ago".
+// It's immutable and safe for concurrent use.
+type RelativeFormatter struct {
+	formatter Formatter
+	clock     Clock
+	justNow   Duration
+}
+
+// NewRelativeFormatter returns a RelativeFormatter using the SystemClock which prints one long, rounded segment
+// and "just now" for less than 10 seconds.
+func NewRelativeFormatter() RelativeFormatter {
+	return RelativeFormatter{
+		formatter: NewFormatter().WithMaxSegments(1).WithStyle(Long).WithRounding(HalfUp),
+		clock:     SystemClock,
+		justNow:   Duration(10 * time.Second),
+	}
+}
+
+// WithFormatter returns a copy of rf printing the distance with f. Its Locale also provides the phrases.
+func (rf RelativeFormatter) WithFormatter(f Formatter) RelativeFormatter {
+	rf.formatter = f
+	return rf
+}
+
+// WithClock returns a copy of rf taking now from clock, or the SystemClock if nil.
+func (rf RelativeFormatter) WithClock(clock Clock) RelativeFormatter {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	rf.clock = clock
+	return rf
+}
+
+// WithJustNow returns a copy of rf printing "just now" for distances below threshold.
+func (rf RelativeFormatter) WithJustNow(threshold Duration) RelativeFormatter {
+	rf.justNow = threshold
+	return rf
+}
+
+// Format prints t relative to now.
+func (rf RelativeFormatter) Format(t time.Time) string {
+	clock := rf.clock
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	return rf.FormatDuration(Duration(t.Sub(clock.Now())))
+}
+
+// FormatDuration prints d as distance from now, i.e. negative ones are in the past.
+func (rf RelativeFormatter) FormatDuration(d Duration) string {
+	locale := rf.formatter.locale
+	if locale == nil {
+		locale = localeEnglish
+	}
+
+	pattern, fallback := locale.Relative.Future, localeEnglish.Relative.Future
+
+	if d < 0 {
+		pattern, fallback = locale.Relative.Past, localeEnglish.Relative.Past
+
+		if d = -d; d < 0 {
+			// -math.MinInt64 overflows
+			d = math.MaxInt64
+		}
+	}
+
+	if d < rf.justNow {
+		pattern, fallback = locale.Relative.Now, localeEnglish.Relative.Now
+	}
+
+	if pattern == "" {
+		pattern = fallback
+	}
+
+	if !strings.Contains(pattern, "{0}") {
+		return pattern
+	}
+
+	formatter := rf.formatter
+	formatter.relative = true
+
+	return strings.Replace(pattern, "{0}", formatter.Format(d), 1)
+}
+
+// RelativeTime prints t relative to clock's now (or the SystemClock's if nil) like "in 3 hours" or "3 hours ago".
+func RelativeTime(t time.Time, clock Clock) string {
+	return NewRelativeFormatter().WithClock(clock).Format(t)
+}
@@ -0,0 +1,94 @@
+package go_pretty_print
+
+import (
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+var relativeNow = time.Date(2020, 2, 20, 20, 20, 20, 0, time.UTC)
+
+func fixedClock() Clock {
+	return ClockFunc(func() time.Time { return relativeNow })
+}
+
+func TestRelativeTime(t *testing.T) {
+	assertRelativeTime(t, 0, "just now")
+	assertRelativeTime(t, 9*time.Second, "just now")
+	assertRelativeTime(t, -9*time.Second, "just now")
+	assertRelativeTime(t, 10*time.Second, "in 10 seconds")
+	assertRelativeTime(t, -s5-s5, "10 seconds ago")
+	assertRelativeTime(t, h3, "in 3 hours")
+	assertRelativeTime(t, -h3, "3 hours ago")
+	assertRelativeTime(t, -h3-m4, "3 hours ago")
+	assertRelativeTime(t, -h3+29*time.Minute, "3 hours ago")
+	assertRelativeTime(t, 90*time.Minute, "in 2 hours")
+	assertRelativeTime(t, time.Hour-10*time.Second, "in 1 hour")
+	assertRelativeTime(t, d2/2, "in 1 day")
+	assertRelativeTime(t, -w1-d2, "1 week ago")
+}
+
+func assertRelativeTime(t *testing.T, d time.Duration, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "RelativeTime(now + %v, fixedClock())", []any{d}, []any{expected},
+		[]any{RelativeTime(relativeNow.Add(d), fixedClock())},
+	)
+}
+
+func TestRelativeFormatter_Format(t *testing.T) {
+	rf := NewRelativeFormatter().WithClock(fixedClock())
+
+	assertRelativeFormatter_Format(t, rf.WithJustNow(0), 0, "in 0 seconds")
+	assertRelativeFormatter_Format(t, rf.WithJustNow(Duration(time.Minute)), -s5*11, "just now")
+	assertRelativeFormatter_Format(t, rf.WithJustNow(Duration(time.Minute)), -time.Minute, "1 minute ago")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(NewFormatter()), -d2-h3-m4, "2d 3h ago")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(NewFormatter().WithRounding(Ceiling)), h3+m4+s5, "in 3h 5m")
+
+	de := NewFormatter().WithMaxSegments(1).WithStyle(Long).WithLocale(mustLookupLocale("de"))
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de), d2, "in 2 Tagen")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de), -d2/2, "vor 1 Tag")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de), -w1*2, "vor 2 Wochen")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de), 0, "gerade eben")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(de.WithMaxSegments(2)), d2+h3, "in 2 Tagen und 3 Stunden")
+
+	ru := de.WithLocale(mustLookupLocale("ru"))
+	assertRelativeFormatter_Format(t, rf.WithFormatter(ru), time.Minute, "через 1 минуту")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(ru), -21*time.Minute, "21 минуту назад")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(ru), -m4, "4 минуты назад")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(ru), h3, "через 3 часа")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(ru.WithStyle(Short)), -h3, "3 ч назад")
+
+	pl := de.WithLocale(mustLookupLocale("pl"))
+	assertRelativeFormatter_Format(t, rf.WithFormatter(pl), time.Hour, "za 1 godzinę")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(pl), -s5*6, "30 sekund temu")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(pl), 0, "przed chwilą")
+
+	eo := NewFormatter().WithLocale(&Locale{Tag: "eo", Plural: pluralOneOther, ShortList: ListPattern{" ", " "}})
+	assertRelativeFormatter_Format(t, rf.WithFormatter(eo), -h3, "3h ago")
+	assertRelativeFormatter_Format(t, rf.WithFormatter(eo), 0, "just now")
+
+	var zero RelativeFormatter
+	AssertCallResult(
+		t, "RelativeFormatter{}.FormatDuration(%v)", []any{math.MinInt64},
+		[]any{"15250w 1d 23h 47m 16s 854ms 775us 807ns ago"}, []any{zero.FormatDuration(math.MinInt64)},
+	)
+}
+
+func assertRelativeFormatter_Format(t *testing.T, rf RelativeFormatter, d time.Duration, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "RelativeFormatter.Format(now + %v)", []any{d}, []any{expected},
+		[]any{rf.Format(relativeNow.Add(d))},
+	)
+}
+
+func TestRelativeFormatter_SystemClock(t *testing.T) {
+	AssertCallResult(
+		t, "RelativeTime(time.Now().Add(%v), nil)", []any{-h3}, []any{"3 hours ago"},
+		[]any{RelativeTime(time.Now().Add(-h3), nil)},
+	)
+}
@@ -0,0 +1,37 @@
+package go_pretty_print
+
+import "math"
+
+// Scaled prints dur in the largest unit it fills with the given significant digits (at least 1),
+// e.g. "1.25h" or "3.70ms". The integer part is never cut, i.e. 15250 weeks stay "15250w".
+func (dur Duration) Scaled(digits int) string {
+	var buf [32]byte
+	return string(dur.AppendScaled(buf[:0], digits))
+}
+
+// AppendScaled appends dur like Scaled to dst.
+func (dur Duration) AppendScaled(dst []byte, digits int) []byte {
+	return durationSystem.AppendScaled(dst, dur, digits)
+}
+
+// intDigits returns the amount of digits of value's integer part
+// or, for positive values below 0.1, the negated amount of zeros after the decimal point.
+func intDigits(value float64) int {
+	digits := 1
+	for ; value >= 10; value /= 10 {
+		digits++
+	}
+
+	if value > 0 && value < 1 {
+		for digits = 0; value < .1; value *= 10 {
+			digits--
+		}
+	}
+
+	return digits
+}
+
+// halfStep returns half the smallest step printable with the given decimals.
+func halfStep(decimals int) float64 {
+	return math.Pow10(-decimals) / 2
+}
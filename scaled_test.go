@@ -0,0 +1,60 @@
+package go_pretty_print
+
+import (
+	. "github.com/Al2Klimov/go-test-utils"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestDuration_Scaled(t *testing.T) {
+	assertDuration_Scaled(t, 0, 3, "0s")
+	assertDuration_Scaled(t, ns8, 3, "8.00ns")
+	assertDuration_Scaled(t, us7+ns8, 3, "7.01us")
+	assertDuration_Scaled(t, 3700*time.Microsecond, 3, "3.70ms")
+	assertDuration_Scaled(t, s5+ms6, 3, "5.01s")
+	assertDuration_Scaled(t, h3/3+15*time.Minute, 3, "1.25h")
+	assertDuration_Scaled(t, h3/3+15*time.Minute, 1, "1h")
+	assertDuration_Scaled(t, h3/3+15*time.Minute, 0, "1h")
+	assertDuration_Scaled(t, h3/3+15*time.Minute, 5, "1.2500h")
+	assertDuration_Scaled(t, 90*time.Minute, 2, "1.5h")
+	assertDuration_Scaled(t, 125*time.Millisecond, 2, "125ms")
+	assertDuration_Scaled(t, d2+4*time.Hour, 3, "2.17d")
+	assertDuration_Scaled(t, w1+d2, 3, "1.29w")
+	assertDuration_Scaled(t, -s5-ms6, 2, "-5.0s")
+	assertDuration_Scaled(t, math.MaxInt64, 3, "15250w")
+	assertDuration_Scaled(t, math.MinInt64, 6, "-15250.3w")
+
+	assertDuration_Scaled(t, 9999*time.Microsecond, 3, "10.0ms")
+	assertDuration_Scaled(t, 99999*time.Microsecond, 3, "100ms")
+	assertDuration_Scaled(t, 59999*time.Millisecond, 3, "1.00m")
+	assertDuration_Scaled(t, 999999*time.Nanosecond, 3, "1.00ms")
+	assertDuration_Scaled(t, 59994*time.Millisecond, 4, "59.99s")
+}
+
+func assertDuration_Scaled(t *testing.T, d time.Duration, digits int, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Duration(%v).Scaled(%d)", []any{d, digits}, []any{expected}, []any{Duration(d).Scaled(digits)})
+}
+
+func TestDuration_Format_Scaled(t *testing.T) {
+	assertDuration_Format(t, 3700*time.Microsecond, "%u", "3.70ms")
+	assertDuration_Format(t, 3700*time.Microsecond, "%.2u", "3.7ms")
+	assertDuration_Format(t, h3/3+15*time.Minute, "%8u", "   1.25h")
+	assertDuration_Format(t, h3/3+15*time.Minute, "%-8u|", "1.25h   |")
+	assertDuration_Format(t, h3/3+15*time.Minute, "%+u", "+1.25h")
+	assertDuration_Format(t, -h3/3-15*time.Minute, "%08u", "-001.25h")
+}
+
+func TestDuration_AppendScaled(t *testing.T) {
+	buf := make([]byte, 0, 32)
+	d := Duration(h3 + m4)
+
+	AssertCallResult(
+		t, "testing.AllocsPerRun(Duration(%v).AppendScaled)", []any{d}, []any{0.0},
+		[]any{testing.AllocsPerRun(100, func() { buf = d.AppendScaled(buf[:0], 3) })},
+	)
+
+	AssertCallResult(t, "Duration(%v).AppendScaled(%#v, 3)", []any{d, "t="}, []any{"t=3.07h"}, []any{string(d.AppendScaled([]byte("t="), 3))})
+}
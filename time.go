@@ -7,31 +7,231 @@ import (
 	"time"
 )
 
-var durationUnits = [8]struct {
-	unit string
-	one  Duration
-}{
-	{"w", Duration(7 * 24 * time.Hour)},
-	{"d", Duration(24 * time.Hour)},
-	{"h", Duration(time.Hour)},
-	{"m", Duration(time.Minute)},
-	{"s", Duration(time.Second)},
-	{"ms", Duration(time.Millisecond)},
-	{"us", Duration(time.Microsecond)},
-	{"ns", Duration(time.Nanosecond)},
+var durationUnits = [8]DurationUnit{
+	UnitWeek, UnitDay, UnitHour, UnitMinute, UnitSecond, UnitMillisecond, UnitMicrosecond, UnitNanosecond,
 }
 
+// durationSystem is the UnitSystem of durationUnits, e.g. used by Duration.String and Duration.Scaled.
+var durationSystem = NewUnitSystem(durationUnits[:]...).WithZeroUnit(UnitSecond)
+
 type Duration time.Duration
 
+// Rounding tells how to treat what's below the last unit a Duration is printed with.
+type Rounding uint8
+
+const (
+	// Truncate rounds towards zero. That's the default.
+	Truncate Rounding = iota
+	// Floor rounds towards negative infinity.
+	Floor
+	// Ceiling rounds towards positive infinity.
+	Ceiling
+	// HalfUp rounds to the nearest value, ties away from zero.
+	HalfUp
+	// HalfEven rounds to the nearest value, ties to an even amount of the last unit.
+	HalfEven
+)
+
+// Style tells how to name the units a Duration is printed with.
+type Style uint8
+
+const (
+	// Short abbreviates units, e.g. "1w 2d". That's the default.
+	Short Style = iota
+	// Long spells units out, e.g. "1 week 2 days".
+	Long
+	// Approximate phrases durations fuzzily, e.g. "about 2 days". See Approximation.
+	Approximate
+)
+
+// RoundedDuration is a Duration printed with a non-default Rounding by String and Format.
+type RoundedDuration struct {
+	Duration
+	Rounding Rounding
+}
+
+func (rd RoundedDuration) String() string {
+	return NewFormatter().WithRounding(rd.Rounding).Format(rd.Duration)
+}
+
+func (rd RoundedDuration) Format(f fmt.State, c rune) {
+	rd.Duration.format(f, c, NewFormatter().WithRounding(rd.Rounding))
+}
+
+// StyledDuration is a Duration printed with a non-default Style by String and Format.
+type StyledDuration struct {
+	Duration
+	Style Style
+}
+
+func (sd StyledDuration) String() string {
+	return NewFormatter().WithStyle(sd.Style).Format(sd.Duration)
+}
+
+func (sd StyledDuration) Format(f fmt.State, c rune) {
+	sd.Duration.format(f, c, NewFormatter().WithStyle(sd.Style))
+}
+
+// UnitDuration is a Duration printed and marshaled as float amount of Unit instead of seconds,
+// e.g. 6.5 with UnitMillisecond. A Unit which isn't positive, e.g. an unset one, means UnitSecond.
+type UnitDuration struct {
+	Duration
+	Unit DurationUnit
+}
+
+// unit returns ud.Unit or, if that isn't positive, UnitSecond.
+func (ud UnitDuration) unit() DurationUnit {
+	if ud.Unit.One <= 0 {
+		return UnitSecond
+	}
+
+	return ud.Unit
+}
+
+// String prints ud with the unit symbol, e.g. "6.5ms".
+func (ud UnitDuration) String() string {
+	unit := ud.unit()
+	return ud.Duration.floatString(unit.One, 'g', -1) + unit.Symbol
+}
+
+// Format works like Duration.Format, but the float verbs print amounts of ud.Unit,
+// with its symbol if the # flag is given. %v and %s print like String.
+func (ud UnitDuration) Format(f fmt.State, c rune) {
+	switch c {
+	case 'b', 'e', 'E', 'f', 'g', 'G':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = -1
+		}
+
+		unit := ud.unit()
+		s := ud.Duration.floatString(unit.One, byte(c), prec)
+		if f.Flag('#') {
+			s += unit.Symbol
+		}
+
+		writePadded(f, s, "", f.Flag('0'))
+	case 'v', 's':
+		if !f.Flag('#') {
+			writePadded(f, ud.String(), "", f.Flag('0'))
+			return
+		}
+
+		fallthrough
+	default:
+		ud.Duration.Format(f, c)
+	}
+}
+
+// MarshalJSON writes a float number of ud.Unit, which may lose nanoseconds of durations beyond 2^50ns.
+func (ud UnitDuration) MarshalJSON() ([]byte, error) {
+	return ud.Duration.appendFloat(nil, ud.unit().One, 'g', -1), nil
+}
+
+// UnmarshalJSON accepts numbers of ud.Unit (as written by MarshalJSON) and strings like Duration.UnmarshalJSON.
+func (ud *UnitDuration) UnmarshalJSON(data []byte) error {
+	return ud.Duration.unmarshalJSON(data, ud.unit().One)
+}
+
+// MarshalJSON writes a number of seconds, exact even where float64 isn't precise enough.
 func (dur Duration) MarshalJSON() ([]byte, error) {
-	return []byte(dur.floatString('g', -1)), nil
+	negative, abs := dur.abs()
+	if abs < 1e15 {
+		return dur.AppendFloat(nil, 'g', -1), nil
+	}
+
+	// From 1e6s on %g would use an exponent and beyond 2^50ns float64 seconds don't round-trip,
+	// so write them exactly as plain decimal.
+	var jsn []byte
+	if negative {
+		jsn = append(jsn, '-')
+	}
+
+	jsn = strconv.AppendUint(jsn, abs/uint64(time.Second), 10)
+
+	if nsec := abs % uint64(time.Second); nsec > 0 {
+		fraction := strconv.FormatUint(nsec+uint64(time.Second), 10)
+
+		jsn = append(jsn, '.')
+		jsn = append(jsn, strings.TrimRight(fraction[1:], "0")...)
+	}
+
+	return jsn, nil
+}
+
+// UnmarshalJSON accepts numbers of seconds (as written by MarshalJSON)
+// and strings in either the Duration.String or the time.Duration syntax.
+func (dur *Duration) UnmarshalJSON(data []byte) error {
+	return dur.unmarshalJSON(data, Duration(time.Second))
+}
+
+// unmarshalJSON is UnmarshalJSON with numbers in the given unit.
+func (dur *Duration) unmarshalJSON(data []byte, unit Duration) error {
+	return unmarshalJSONValue(data, dur, func(s string) (Duration, error) {
+		return parseDecimal(s, unit)
+	}, parseDurationText)
+}
+
+// MarshalText writes all units, unlike String.
+func (dur Duration) MarshalText() ([]byte, error) {
+	return Formatter{}.AppendFormat(nil, dur), nil
+}
+
+// UnmarshalText accepts the Duration.String and the time.Duration syntax.
+func (dur *Duration) UnmarshalText(text []byte) error {
+	d, err := parseDurationText(string(text))
+	if err == nil {
+		*dur = d
+	}
+
+	return err
 }
 
 func (dur Duration) String() string {
-	return dur.string(2)
+	return NewFormatter().Format(dur)
+}
+
+// AppendString appends dur with at most the given amount of units (or all if units < 1) to dst.
+// Unlike String it doesn't allocate (unless dst is too small).
+func (dur Duration) AppendString(dst []byte, units int) []byte {
+	return NewFormatter().WithMaxSegments(units).AppendFormat(dst, dur)
+}
+
+// AppendFloat appends dur in seconds to dst like strconv.AppendFloat.
+func (dur Duration) AppendFloat(dst []byte, fmt byte, prec int) []byte {
+	return dur.appendFloat(dst, Duration(time.Second), fmt, prec)
+}
+
+// In returns dur printed and marshaled as float amount of unit, e.g. dur.In(UnitMillisecond).
+// Panics if unit isn't positive.
+func (dur Duration) In(unit DurationUnit) UnitDuration {
+	if unit.One <= 0 {
+		panic("go_pretty_print: unit " + unit.Symbol + " must be positive")
+	}
+
+	return UnitDuration{dur, unit}
+}
+
+// LongString is like String, but spells the units out.
+func (dur Duration) LongString() string {
+	return NewFormatter().WithStyle(Long).Format(dur)
+}
+
+// Rounded returns dur printed with the given Rounding instead of truncated.
+func (dur Duration) Rounded(rounding Rounding) RoundedDuration {
+	return RoundedDuration{dur, rounding}
+}
+
+// Styled returns dur printed with the given Style.
+func (dur Duration) Styled(style Style) StyledDuration {
+	return StyledDuration{dur, style}
 }
 
 func (dur Duration) Format(f fmt.State, c rune) {
+	dur.format(f, c, NewFormatter())
+}
+
+func (dur Duration) format(f fmt.State, c rune, formatter Formatter) {
 	switch c {
 	case 'b', 'e', 'E', 'f', 'g', 'G':
 		prec, hasPrec := f.Precision()
@@ -39,60 +239,103 @@ func (dur Duration) Format(f fmt.State, c rune) {
 			prec = -1
 		}
 
-		fmt.Fprint(f, dur.floatString(byte(c), prec))
-	case 's', 'v':
+		writePadded(f, dur.floatString(Duration(time.Second), byte(c), prec), "", f.Flag('0'))
+	case 'v':
+		if f.Flag('#') {
+			writePadded(f, dur.GoString(), "", false)
+			return
+		}
+
+		fallthrough
+	case 's':
 		prec, hasPrec := f.Precision()
 		if !hasPrec {
 			prec = 1
 		}
 
-		fmt.Fprint(f, dur.string(uint8(prec+1)))
+		formatter = formatter.WithMaxSegments(prec + 1)
+		if c == 's' && f.Flag('#') {
+			formatter = formatter.WithStyle(Long)
+		}
+
+		writePadded(f, formatter.Format(dur), "", f.Flag('0'))
+	case 'a':
+		writePadded(f, formatter.WithStyle(Approximate).Format(dur), "", f.Flag('0'))
+	case 'c':
+		prec, _ := f.Precision()
+		writePadded(f, dur.Clock(prec, f.Flag('#')), "", f.Flag('0'))
+	case 'u':
+		prec, hasPrec := f.Precision()
+		if !hasPrec {
+			prec = 3
+		}
+
+		writePadded(f, dur.Scaled(prec), "", f.Flag('0'))
+	case 'd', 'o', 'O', 'x', 'X':
+		dur.formatInt(f, c)
 	default:
 		fmt.Fprintf(f, "%%!%c(go_pretty_print.Duration=%s)", c, time.Duration(dur))
 	}
 }
 
-func (dur Duration) floatString(fmt byte, prec int) string {
-	return strconv.FormatFloat(float64(dur)/float64(time.Second), fmt, prec, 64)
+var durationUnitsGo = [8]string{
+	"7*24*time.Hour", "24*time.Hour", "time.Hour", "time.Minute",
+	"time.Second", "time.Millisecond", "time.Microsecond", "time.Nanosecond",
 }
 
-func (dur Duration) string(units uint8) string {
-	if dur == 0 {
-		return "0s"
-	}
-
-	negative := dur < 0
-	if negative {
-		dur = -dur
-	}
-
-	largestUnit := uint8(7)
+// GoString prints dur as Go expression, e.g. "go_pretty_print.Duration(2*24*time.Hour + 3*time.Hour)"
+// or "go_pretty_print.Duration(-2*24*time.Hour - 3*time.Hour)".
+func (dur Duration) GoString() string {
+	negative, abs := dur.abs()
+	terms := []string{}
 
 	for i, unit := range durationUnits {
-		if dur >= unit.one {
-			largestUnit = uint8(i)
-			break
+		if amount := abs / uint64(unit.One); amount > 0 {
+			term := durationUnitsGo[i]
+			if amount > 1 {
+				term = strconv.FormatUint(amount, 10) + "*" + term
+			}
+
+			terms = append(terms, term)
+			abs %= uint64(unit.One)
 		}
 	}
 
-	segments := []string{}
+	// Negating each term rather than the sum keeps every partial sum within int64, e.g. for math.MinInt64.
+	expr := ""
 
-	for i := largestUnit; i < 8 && units > 0; i++ {
-		unit := durationUnits[i]
-		amount := dur / unit.one
-		dur %= unit.one
-
-		if amount > 0 {
-			segments = append(segments, strconv.FormatInt(int64(amount), 10)+unit.unit)
-			units--
-		}
+	switch {
+	case len(terms) == 0:
+		expr = "0"
+	case negative:
+		expr = "-" + strings.Join(terms, " - ")
+	default:
+		expr = strings.Join(terms, " + ")
 	}
 
-	result := strings.Join(segments, " ")
+	return "go_pretty_print.Duration(" + expr + ")"
+}
 
-	if negative {
-		result = "-" + result
+// formatInt prints dur as integer nanoseconds (or seconds with %#d) like fmt prints integers.
+func (dur Duration) formatInt(f fmt.State, c rune) {
+	negative, abs := dur.abs()
+	if c == 'd' && f.Flag('#') {
+		abs /= uint64(time.Second)
 	}
 
-	return result
+	writeInt(f, c, negative, abs)
+}
+
+func (dur Duration) floatString(unit Duration, fmt byte, prec int) string {
+	var buf [32]byte
+	return string(dur.appendFloat(buf[:0], unit, fmt, prec))
+}
+
+func (dur Duration) appendFloat(dst []byte, unit Duration, fmt byte, prec int) []byte {
+	return strconv.AppendFloat(dst, float64(dur)/float64(unit), fmt, prec, 64)
+}
+
+// abs returns the magnitude of dur as uint64, which (unlike -dur) also works for math.MinInt64.
+func (dur Duration) abs() (negative bool, abs uint64) {
+	return absUint(dur)
 }
@@ -4,6 +4,15 @@ import (
 	"encoding/json"
 	"fmt"
 	. "github.com/Al2Klimov/go-test-utils"
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	goparser "go/parser"
+	"go/token"
+	"go/types"
+	"math"
+	"reflect"
+	"strings"
 	"testing"
 	"time"
 )
@@ -39,6 +48,15 @@ func TestDuration_MarshalJSON(t *testing.T) {
 	assertDuration_MarshalJSON(t, -h3, "-10800")
 	assertDuration_MarshalJSON(t, -d2, "-172800")
 	assertDuration_MarshalJSON(t, -w1, "-604800")
+
+	assertDuration_MarshalJSON(t, 999999*time.Second+ms6, "999999.006")
+	assertDuration_MarshalJSON(t, 1e6*time.Second, "1000000")
+	assertDuration_MarshalJSON(t, 6*d2, "1036800")
+	assertDuration_MarshalJSON(t, -7*d2, "-1209600")
+	assertDuration_MarshalJSON(t, 1<<50+1, "1125899.906842625")
+	assertDuration_MarshalJSON(t, 2000*w1, "1209600000")
+	assertDuration_MarshalJSON(t, math.MaxInt64, "9223372036.854775807")
+	assertDuration_MarshalJSON(t, math.MinInt64, "-9223372036.854775808")
 }
 
 func assertDuration_MarshalJSON(t *testing.T, d time.Duration, expected string) {
@@ -48,6 +66,107 @@ func assertDuration_MarshalJSON(t *testing.T, d time.Duration, expected string)
 	AssertCallResult(t, "json.Marshal(Duration(%v))", []any{d}, []any{[]byte(expected), nil}, []any{jsn, err})
 }
 
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	assertDuration_UnmarshalJSON(t, "0", 0)
+
+	for _, d := range [...]time.Duration{ns8, us7, ms6, s5, m4, h3, d2, w1, w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8} {
+		for _, d := range [2]time.Duration{d, -d} {
+			jsn, _ := json.Marshal(Duration(d))
+			assertDuration_UnmarshalJSON(t, string(jsn), d)
+		}
+	}
+
+	assertDuration_UnmarshalJSON(t, "1.5", s5*3/10)
+	assertDuration_UnmarshalJSON(t, "1.5e+2", 150*time.Second)
+	assertDuration_UnmarshalJSON(t, "1E-9", time.Nanosecond)
+	assertDuration_UnmarshalJSON(t, "4e-10", 0)
+	assertDuration_UnmarshalJSON(t, "5e-10", time.Nanosecond)
+	assertDuration_UnmarshalJSON(t, "-5e-10", -time.Nanosecond)
+	assertDuration_UnmarshalJSON(t, "1e-999999999", 0)
+	assertDuration_UnmarshalJSON(t, "9223372036.854775807", math.MaxInt64)
+	assertDuration_UnmarshalJSON(t, "-9223372036.854775808", math.MinInt64)
+
+	assertDuration_UnmarshalJSON(t, `"0s"`, 0)
+	assertDuration_UnmarshalJSON(t, `"1w 2d"`, w1+d2)
+	assertDuration_UnmarshalJSON(t, `"-7us 8ns"`, -us7-ns8)
+	assertDuration_UnmarshalJSON(t, `"1h2m3.5s"`, time.Hour+2*time.Minute+3500*time.Millisecond)
+	assertDuration_UnmarshalJSON(t, `"-1.5h"`, -90*time.Minute)
+	assertDuration_UnmarshalJSON(t, `"7µs"`, us7)
+}
+
+func assertDuration_UnmarshalJSON(t *testing.T, jsn string, expected time.Duration) {
+	t.Helper()
+
+	var d Duration
+	err := json.Unmarshal([]byte(jsn), &d)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{jsn}, []any{Duration(expected), nil}, []any{d, err})
+}
+
+func TestDuration_UnmarshalJSON_Error(t *testing.T) {
+	assertDuration_UnmarshalJSON_Error(t, "9223372036.854775808", &ParseError{"9223372036.854775808", 0, "duration out of range"})
+	assertDuration_UnmarshalJSON_Error(t, "-9223372036.854775809", &ParseError{"-9223372036.854775809", 0, "duration out of range"})
+	assertDuration_UnmarshalJSON_Error(t, "9.223372036854776e+09", &ParseError{"9.223372036854776e+09", 0, "duration out of range"})
+	assertDuration_UnmarshalJSON_Error(t, "-9.223372036854776e+09", &ParseError{"-9.223372036854776e+09", 0, "duration out of range"})
+	assertDuration_UnmarshalJSON_Error(t, "1e999999999", &ParseError{"1e999999999", 0, "duration out of range"})
+	assertDuration_UnmarshalJSON_Error(t, `"1x"`, &ParseError{"1x", 1, "unknown unit"})
+	assertDuration_UnmarshalJSON_Error(
+		t, `"16000w"`, &ParseError{"16000w", 0, "duration out of range"},
+	)
+	assertDuration_UnmarshalJSON_Error(
+		t, "true", &json.UnmarshalTypeError{Value: "true", Type: reflect.TypeOf(Duration(0))},
+	)
+}
+
+func assertDuration_UnmarshalJSON_Error(t *testing.T, jsn string, expected error) {
+	t.Helper()
+
+	d := Duration(42)
+	err := json.Unmarshal([]byte(jsn), &d)
+	AssertCallResult(t, "json.Unmarshal(%#v)", []any{jsn}, []any{Duration(42), expected}, []any{d, err})
+}
+
+func TestDuration_MarshalText(t *testing.T) {
+	assertDuration_MarshalText(t, 0, "0s")
+
+	assertDuration_MarshalText(t, ns8, "8ns")
+	assertDuration_MarshalText(t, ms6+us7+ns8, "6ms 7us 8ns")
+	assertDuration_MarshalText(t, w1+d2+h3+m4+s5+ms6+us7+ns8, "1w 2d 3h 4m 5s 6ms 7us 8ns")
+	assertDuration_MarshalText(t, w1+ns8, "1w 8ns")
+
+	assertDuration_MarshalText(t, -ns8, "-8ns")
+	assertDuration_MarshalText(t, -ms6-us7-ns8, "-6ms 7us 8ns")
+	assertDuration_MarshalText(t, -w1-d2-h3-m4-s5-ms6-us7-ns8, "-1w 2d 3h 4m 5s 6ms 7us 8ns")
+	assertDuration_MarshalText(t, -w1-ns8, "-1w 8ns")
+}
+
+func assertDuration_MarshalText(t *testing.T, d time.Duration, expected string) {
+	t.Helper()
+
+	text, err := Duration(d).MarshalText()
+	AssertCallResult(t, "Duration(%v).MarshalText()", []any{d}, []any{[]byte(expected), nil}, []any{text, err})
+}
+
+func TestDuration_UnmarshalText(t *testing.T) {
+	assertDuration_UnmarshalText(t, "0s", 0, nil)
+	assertDuration_UnmarshalText(t, "1w 2d 3h 4m 5s 6ms 7us 8ns", w1+d2+h3+m4+s5+ms6+us7+ns8, nil)
+	assertDuration_UnmarshalText(t, "-6ms 7us 8ns", -ms6-us7-ns8, nil)
+	assertDuration_UnmarshalText(t, "1h30m", 90*time.Minute, nil)
+	assertDuration_UnmarshalText(t, "2.5s", 2500*time.Millisecond, nil)
+
+	assertDuration_UnmarshalText(t, "", 42, &ParseError{"", 0, "empty duration"})
+	assertDuration_UnmarshalText(t, "2d 1w", 42, &ParseError{"2d 1w", 4, "unit out of order"})
+}
+
+func assertDuration_UnmarshalText(t *testing.T, text string, expected time.Duration, expectedErr error) {
+	t.Helper()
+
+	d := Duration(42)
+	err := d.UnmarshalText([]byte(text))
+	AssertCallResult(
+		t, "Duration.UnmarshalText(%#v)", []any{text}, []any{Duration(expected), expectedErr}, []any{d, err},
+	)
+}
+
 func TestDuration_String(t *testing.T) {
 	assertDuration_String(t, 0, "0s")
 
@@ -76,6 +195,398 @@ func assertDuration_String(t *testing.T, d time.Duration, expected string) {
 	AssertCallResult(t, "Duration(%v).String()", []any{d}, []any{expected}, []any{Duration(d).String()})
 }
 
+func TestDuration_AppendString(t *testing.T) {
+	all := w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8
+
+	assertDuration_AppendString(t, "", 0, 2, "0s")
+	assertDuration_AppendString(t, "took ", all, 2, "took 1w 2d")
+	assertDuration_AppendString(t, "took ", -all, 3, "took -1w 2d 3h")
+	assertDuration_AppendString(t, "", all, 0, "1w 2d 3h 4m 5s 6ms 7us 8ns")
+	assertDuration_AppendString(t, "", math.MinInt64, -1, "-15250w 1d 23h 47m 16s 854ms 775us 808ns")
+}
+
+func assertDuration_AppendString(t *testing.T, dst string, d time.Duration, units int, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "Duration(%v).AppendString(%#v, %d)", []any{d, dst, units},
+		[]any{expected}, []any{string(Duration(d).AppendString([]byte(dst), units))},
+	)
+}
+
+func TestDuration_AppendFloat(t *testing.T) {
+	assertDuration_AppendFloat(t, "", 0, 'g', -1, "0")
+	assertDuration_AppendFloat(t, "t=", h3+500*time.Millisecond, 'f', 3, "t=10800.500")
+	assertDuration_AppendFloat(t, "", -ms6, 'g', -1, "-0.006")
+	assertDuration_AppendFloat(t, "", ns8, 'e', 2, "8.00e-09")
+}
+
+func assertDuration_AppendFloat(t *testing.T, dst string, d time.Duration, fmt byte, prec int, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "Duration(%v).AppendFloat(%#v, %q, %d)", []any{d, dst, fmt, prec},
+		[]any{expected}, []any{string(Duration(d).AppendFloat([]byte(dst), fmt, prec))},
+	)
+}
+
+func TestDuration_Append_Allocs(t *testing.T) {
+	d := Duration(w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8)
+	buf := make([]byte, 0, 64)
+	long := NewFormatter().WithMaxSegments(0).WithStyle(Long).WithLocale(mustLookupLocale("de"))
+
+	for _, c := range [...]struct {
+		name string
+		call func()
+	}{
+		{"AppendString", func() { buf = d.AppendString(buf[:0], 0) }},
+		{"AppendFloat", func() { buf = d.AppendFloat(buf[:0], 'g', -1) }},
+		{"Formatter.AppendFormat", func() { buf = long.AppendFormat(buf[:0], -d) }},
+	} {
+		AssertCallResult(t, "testing.AllocsPerRun(%s)", []any{c.name}, []any{0.0}, []any{testing.AllocsPerRun(100, c.call)})
+	}
+}
+
+func BenchmarkDuration_String(b *testing.B) {
+	d := Duration(w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		_ = d.String()
+	}
+}
+
+func BenchmarkDuration_AppendString(b *testing.B) {
+	d := Duration(w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		buf = d.AppendString(buf[:0], 2)
+	}
+}
+
+func BenchmarkDuration_AppendString_All(b *testing.B) {
+	d := Duration(w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8)
+	buf := make([]byte, 0, 64)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		buf = d.AppendString(buf[:0], 0)
+	}
+}
+
+func BenchmarkDuration_AppendFloat(b *testing.B) {
+	d := Duration(w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8)
+	buf := make([]byte, 0, 32)
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		buf = d.AppendFloat(buf[:0], 'g', -1)
+	}
+}
+
+func TestDuration_LongString(t *testing.T) {
+	assertDuration_LongString(t, 0, "0 seconds")
+
+	assertDuration_LongString(t, time.Nanosecond, "1 nanosecond")
+	assertDuration_LongString(t, ns8, "8 nanoseconds")
+	assertDuration_LongString(t, us7+time.Nanosecond, "7 microseconds 1 nanosecond")
+	assertDuration_LongString(t, ms6+us7+ns8, "6 milliseconds 7 microseconds")
+	assertDuration_LongString(t, time.Millisecond, "1 millisecond")
+	assertDuration_LongString(t, time.Microsecond, "1 microsecond")
+	assertDuration_LongString(t, time.Second+us7, "1 second 7 microseconds")
+	assertDuration_LongString(t, s5, "5 seconds")
+	assertDuration_LongString(t, time.Minute, "1 minute")
+	assertDuration_LongString(t, m4, "4 minutes")
+	assertDuration_LongString(t, time.Hour, "1 hour")
+	assertDuration_LongString(t, h3, "3 hours")
+	assertDuration_LongString(t, d2/2, "1 day")
+	assertDuration_LongString(t, d2, "2 days")
+	assertDuration_LongString(t, w1+d2, "1 week 2 days")
+	assertDuration_LongString(t, 2*w1+d2/2, "2 weeks 1 day")
+
+	assertDuration_LongString(t, -time.Hour, "-1 hour")
+	assertDuration_LongString(t, -w1-d2, "-1 week 2 days")
+}
+
+func assertDuration_LongString(t *testing.T, d time.Duration, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Duration(%v).LongString()", []any{d}, []any{expected}, []any{Duration(d).LongString()})
+}
+
+func TestStyledDuration(t *testing.T) {
+	assertStyledDuration(t, w1+d2+h3, Short, "%v", "1w 2d")
+	assertStyledDuration(t, w1+d2+h3, Long, "%v", "1 week 2 days")
+	assertStyledDuration(t, w1+d2+h3, Long, "%.2s", "1 week 2 days 3 hours")
+	assertStyledDuration(t, w1+d2+h3, Long, "%.0v", "1 week")
+	assertStyledDuration(t, -s5, Long, "%s", "-5 seconds")
+	assertStyledDuration(t, s5, Long, "%.1f", "5.0")
+
+	AssertCallResult(
+		t, "Duration(%v).Styled(Long).String()", []any{ms6}, []any{"6 milliseconds"},
+		[]any{Duration(ms6).Styled(Long).String()},
+	)
+}
+
+func assertStyledDuration(t *testing.T, d time.Duration, s Style, format, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t,
+		"fmt.Sprintf(%#v, Duration(%v).Styled(%d))",
+		[]any{format, d, s},
+		[]any{expected},
+		[]any{fmt.Sprintf(format, Duration(d).Styled(s))},
+	)
+}
+
+func TestUnitDuration_Format(t *testing.T) {
+	assertUnitDuration_Format(t, ms6, UnitMillisecond, "%.3f", "6.000")
+	assertUnitDuration_Format(t, ms6+500*time.Microsecond, UnitMillisecond, "%g", "6.5")
+	assertUnitDuration_Format(t, ms6+500*time.Microsecond, UnitMillisecond, "%#.1f", "6.5ms")
+	assertUnitDuration_Format(t, ms6+500*time.Microsecond, UnitMillisecond, "%#8.2f|", "  6.50ms|")
+	assertUnitDuration_Format(t, -ms6, UnitMicrosecond, "%+e", "-6e+03")
+	assertUnitDuration_Format(t, 90*time.Minute, UnitHour, "%v", "1.5h")
+	assertUnitDuration_Format(t, 90*time.Minute, UnitHour, "%6s", "  1.5h")
+	assertUnitDuration_Format(t, d2+h3, UnitDay, "%.2f", "2.12")
+	assertUnitDuration_Format(t, w1, UnitWeek, "%#g", "1w")
+	assertUnitDuration_Format(t, ms6, UnitMillisecond, "%d", "6000000")
+	assertUnitDuration_Format(t, ms6, UnitMillisecond, "%#s", "6 milliseconds")
+
+	AssertCallResult(
+		t, "Duration(%v).In(UnitMillisecond).String()", []any{ms6 + us7}, []any{"6.007ms"},
+		[]any{Duration(ms6 + us7).In(UnitMillisecond).String()},
+	)
+}
+
+func assertUnitDuration_Format(t *testing.T, d time.Duration, u DurationUnit, format, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t,
+		"fmt.Sprintf(%#v, Duration(%v).In(%s))",
+		[]any{format, d, u.Symbol},
+		[]any{expected},
+		[]any{fmt.Sprintf(format, Duration(d).In(u))},
+	)
+}
+
+func TestUnitDuration_JSON(t *testing.T) {
+	type metrics struct {
+		Latency UnitDuration `json:"latency_ms"`
+	}
+
+	jsn, err := json.Marshal(metrics{Duration(ms6 + 500*time.Microsecond).In(UnitMillisecond)})
+	AssertCallResult(
+		t, "json.Marshal(%v)", []any{ms6 + 500*time.Microsecond},
+		[]any{`{"latency_ms":6.5}`, nil}, []any{string(jsn), err},
+	)
+
+	for _, c := range [...]struct {
+		json     string
+		expected time.Duration
+	}{
+		{`{"latency_ms":6.5}`, ms6 + 500*time.Microsecond},
+		{`{"latency_ms":-0.000001}`, -time.Nanosecond},
+		{`{"latency_ms":"1s 5ms"}`, time.Second + 5*time.Millisecond},
+		{`{"latency_ms":null}`, 0},
+	} {
+		m := metrics{UnitDuration{Unit: UnitMillisecond}}
+		err := json.Unmarshal([]byte(c.json), &m)
+
+		AssertCallResult(
+			t, "json.Unmarshal(%#v)", []any{c.json},
+			[]any{Duration(c.expected).In(UnitMillisecond), nil}, []any{m.Latency, err},
+		)
+	}
+}
+
+func TestUnitDuration_UnsetUnit(t *testing.T) {
+	type metrics struct {
+		Latency UnitDuration `json:"latency"`
+	}
+
+	m := metrics{UnitDuration{Duration: Duration(time.Second + 500*time.Millisecond)}}
+	jsn, err := json.Marshal(m)
+	AssertCallResult(t, "json.Marshal(%v)", []any{m.Latency.Duration}, []any{`{"latency":1.5}`, nil}, []any{string(jsn), err})
+
+	AssertCallResult(
+		t, "fmt.Sprintf(%#v, UnitDuration{Duration: %v})", []any{"%v %#.2f", m.Latency.Duration},
+		[]any{"1.5s 1.50s"}, []any{fmt.Sprintf("%v %#.2f", m.Latency, m.Latency)},
+	)
+
+	m = metrics{}
+	err = json.Unmarshal([]byte(`{"latency":6.5}`), &m)
+	AssertCallResult(
+		t, "json.Unmarshal(%#v)", []any{`{"latency":6.5}`},
+		[]any{Duration(6*time.Second + 500*time.Millisecond), nil}, []any{m.Latency.Duration, err},
+	)
+}
+
+func TestDuration_In(t *testing.T) {
+	defer func() {
+		AssertCallResult(
+			t, "Duration(%v).In(%#v)", []any{ms6, DurationUnit{Symbol: "x"}},
+			[]any{"go_pretty_print: unit x must be positive"}, []any{recover()},
+		)
+	}()
+
+	Duration(ms6).In(DurationUnit{Symbol: "x"})
+}
+
+func TestRoundedDuration_String(t *testing.T) {
+	h1m59s59 := time.Hour + 59*time.Minute + 59*time.Second
+
+	assertRoundedDuration_String(t, h1m59s59, Truncate, "1h 59m")
+	assertRoundedDuration_String(t, h1m59s59, Floor, "1h 59m")
+	assertRoundedDuration_String(t, h1m59s59, Ceiling, "2h")
+	assertRoundedDuration_String(t, h1m59s59, HalfUp, "2h")
+	assertRoundedDuration_String(t, h1m59s59, HalfEven, "2h")
+
+	assertRoundedDuration_String(t, -h1m59s59, Truncate, "-1h 59m")
+	assertRoundedDuration_String(t, -h1m59s59, Floor, "-2h")
+	assertRoundedDuration_String(t, -h1m59s59, Ceiling, "-1h 59m")
+	assertRoundedDuration_String(t, -h1m59s59, HalfUp, "-2h")
+	assertRoundedDuration_String(t, -h1m59s59, HalfEven, "-2h")
+
+	m59s59ms900 := 59*time.Minute + 59*time.Second + 900*time.Millisecond
+
+	assertRoundedDuration_String(t, m59s59ms900, Truncate, "59m 59s")
+	assertRoundedDuration_String(t, m59s59ms900, HalfUp, "1h")
+	assertRoundedDuration_String(t, -m59s59ms900, HalfUp, "-1h")
+
+	assertRoundedDuration_String(t, s5+500*time.Millisecond, HalfUp, "5s 500ms")
+	assertRoundedDuration_String(t, m4+s5+500*time.Millisecond, HalfUp, "4m 6s")
+	assertRoundedDuration_String(t, m4+s5+500*time.Millisecond, HalfEven, "4m 6s")
+	assertRoundedDuration_String(t, m4+s5+499*time.Millisecond, HalfUp, "4m 5s")
+	assertRoundedDuration_String(t, m4+4*time.Second+500*time.Millisecond, HalfUp, "4m 5s")
+	assertRoundedDuration_String(t, m4+4*time.Second+500*time.Millisecond, HalfEven, "4m 4s")
+	assertRoundedDuration_String(t, -m4-4*time.Second-500*time.Millisecond, HalfEven, "-4m 4s")
+	assertRoundedDuration_String(t, m4+4*time.Second+501*time.Millisecond, HalfEven, "4m 5s")
+
+	assertRoundedDuration_String(t, w1+ns8, Ceiling, "1w 8ns")
+	assertRoundedDuration_String(t, w1+d2+ns8, Ceiling, "1w 3d")
+	assertRoundedDuration_String(t, w1+6*d2/2+23*time.Hour+ns8, Ceiling, "2w")
+
+	assertRoundedDuration_String(t, 0, Ceiling, "0s")
+	assertRoundedDuration_String(t, ns8, Ceiling, "8ns")
+	assertRoundedDuration_String(t, math.MaxInt64, Ceiling, "15250w 2d")
+	assertRoundedDuration_String(t, math.MinInt64, Floor, "-15250w 2d")
+}
+
+func assertRoundedDuration_String(t *testing.T, d time.Duration, r Rounding, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t, "Duration(%v).Rounded(%d).String()", []any{d, r}, []any{expected}, []any{Duration(d).Rounded(r).String()},
+	)
+}
+
+func TestRoundedDuration_Format(t *testing.T) {
+	assertRoundedDuration_Format(t, d2/2+23*time.Hour, Truncate, "%.0s", "1d")
+	assertRoundedDuration_Format(t, d2/2+23*time.Hour, HalfUp, "%.0s", "2d")
+	assertRoundedDuration_Format(t, d2/2+23*time.Hour, HalfUp, "%.1v", "1d 23h")
+	assertRoundedDuration_Format(t, d2/2+11*time.Hour, HalfUp, "%.0s", "1d")
+	assertRoundedDuration_Format(t, d2/2+12*time.Hour, HalfUp, "%.0s", "2d")
+	assertRoundedDuration_Format(t, d2/2+12*time.Hour, HalfEven, "%.0s", "2d")
+	assertRoundedDuration_Format(t, d2+d2/4, HalfEven, "%.0s", "2d")
+	assertRoundedDuration_Format(t, -d2/2-12*time.Hour, Ceiling, "%.0s", "-1d")
+	assertRoundedDuration_Format(t, h3+m4+s5+ms6, HalfUp, "%.2s", "3h 4m 5s")
+	assertRoundedDuration_Format(t, h3+m4+s5+ms6, Ceiling, "%.2s", "3h 4m 6s")
+	assertRoundedDuration_Format(t, s5+ms6, HalfUp, "%.3f", "5.006")
+}
+
+func assertRoundedDuration_Format(t *testing.T, d time.Duration, r Rounding, format, expected string) {
+	t.Helper()
+
+	AssertCallResult(
+		t,
+		"fmt.Sprintf(%#v, Duration(%v).Rounded(%d))",
+		[]any{format, d, r},
+		[]any{expected},
+		[]any{fmt.Sprintf(format, Duration(d).Rounded(r))},
+	)
+}
+
+func TestDuration_GoString(t *testing.T) {
+	assertDuration_GoString(t, 0, "go_pretty_print.Duration(0)")
+
+	assertDuration_GoString(t, time.Nanosecond, "go_pretty_print.Duration(time.Nanosecond)")
+	assertDuration_GoString(t, ns8, "go_pretty_print.Duration(8*time.Nanosecond)")
+	assertDuration_GoString(t, us7, "go_pretty_print.Duration(7*time.Microsecond)")
+	assertDuration_GoString(t, ms6, "go_pretty_print.Duration(6*time.Millisecond)")
+	assertDuration_GoString(t, s5, "go_pretty_print.Duration(5*time.Second)")
+	assertDuration_GoString(t, m4, "go_pretty_print.Duration(4*time.Minute)")
+	assertDuration_GoString(t, h3, "go_pretty_print.Duration(3*time.Hour)")
+	assertDuration_GoString(t, d2, "go_pretty_print.Duration(2*24*time.Hour)")
+	assertDuration_GoString(t, w1, "go_pretty_print.Duration(7*24*time.Hour)")
+	assertDuration_GoString(t, d2+h3, "go_pretty_print.Duration(2*24*time.Hour + 3*time.Hour)")
+	assertDuration_GoString(t, d2/2+time.Hour, "go_pretty_print.Duration(24*time.Hour + time.Hour)")
+	assertDuration_GoString(t, w1+ns8, "go_pretty_print.Duration(7*24*time.Hour + 8*time.Nanosecond)")
+
+	assertDuration_GoString(t, -h3, "go_pretty_print.Duration(-3*time.Hour)")
+	assertDuration_GoString(t, -d2-h3, "go_pretty_print.Duration(-2*24*time.Hour - 3*time.Hour)")
+
+	for _, d := range [...]time.Duration{
+		0, w1 + d2 + h3 + m4 + s5 + ms6 + us7 + ns8, 1<<53 + 1, math.MaxInt64, math.MinInt64, math.MinInt64 + 1,
+	} {
+		for _, d := range [2]time.Duration{d, -d} {
+			assertDuration_GoString_Eval(t, d)
+		}
+	}
+}
+
+func assertDuration_GoString(t *testing.T, d time.Duration, expected string) {
+	t.Helper()
+
+	AssertCallResult(t, "Duration(%v).GoString()", []any{d}, []any{expected}, []any{Duration(d).GoString()})
+	AssertCallResult(t, "fmt.Sprintf(%#v, Duration(%v))", []any{"%#v", d}, []any{expected}, []any{fmt.Sprintf("%#v", Duration(d))})
+}
+
+// goStringImporter caches the time package for assertDuration_GoString_Eval.
+var goStringImporter = importer.ForCompiler(token.NewFileSet(), "source", nil)
+
+// assertDuration_GoString_Eval type-checks the Go constant expression Duration.GoString returns and compares its value.
+func assertDuration_GoString_Eval(t *testing.T, d time.Duration) {
+	t.Helper()
+
+	s := Duration(d).GoString()
+	src := "package p\n\nimport \"time\"\n\ntype Duration time.Duration\n\nconst c = " +
+		strings.TrimPrefix(s, "go_pretty_print.") + "\n"
+
+	fset := token.NewFileSet()
+	file, err := goparser.ParseFile(fset, "p.go", src, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pkg, err := (&types.Config{Importer: goStringImporter}).Check("p", fset, []*ast.File{file}, nil)
+	if err != nil {
+		t.Fatalf("%s: %v", s, err)
+	}
+
+	value, _ := constant.Int64Val(pkg.Scope().Lookup("c").(*types.Const).Val())
+	AssertCallResult(t, "eval(%#v)", []any{s}, []any{int64(d)}, []any{value})
+}
+
+func TestDuration_Format_GoSyntax(t *testing.T) {
+	type fixture struct {
+		Timeout Duration
+	}
+
+	AssertCallResult(
+		t, "fmt.Sprintf(%#v, %v)", []any{"%#v", fixture{Duration(d2 + h3)}},
+		[]any{"go_pretty_print.fixture{Timeout:go_pretty_print.Duration(2*24*time.Hour + 3*time.Hour)}"},
+		[]any{fmt.Sprintf("%#v", fixture{Duration(d2 + h3)})},
+	)
+
+	assertDuration_Format(t, d2+h3, "%v", "2d 3h")
+	assertDuration_Format(t, d2+h3, "%#s", "2 days 3 hours")
+	assertDuration_Format(t, h3, "%#40v", "   go_pretty_print.Duration(3*time.Hour)")
+}
+
 func TestDuration_Format_0(t *testing.T) {
 	assertDuration_Format(t, 0, "%b", "0p-1074")
 	assertDuration_Format(t, 0, "%.2b", "0p-1074")
@@ -97,7 +608,9 @@ func TestDuration_Format_0(t *testing.T) {
 		assertDuration_Format(t, 0, "%.128"+c, "0s")
 	}
 
-	assertDuration_Format(t, 0, "%d", "%!d(go_pretty_print.Duration=0s)")
+	assertDuration_Format(t, 0, "%d", "0")
+	assertDuration_Format(t, 0, "%#d", "0")
+	assertDuration_Format(t, 0, "%q", "%!q(go_pretty_print.Duration=0s)")
 }
 
 func TestDuration_Format_NS(t *testing.T) {
@@ -121,7 +634,9 @@ func TestDuration_Format_NS(t *testing.T) {
 		assertDuration_Format(t, ns8, "%.128"+c, "8ns")
 	}
 
-	assertDuration_Format(t, ns8, "%d", "%!d(go_pretty_print.Duration=8ns)")
+	assertDuration_Format(t, ns8, "%d", "8")
+	assertDuration_Format(t, ns8, "%#d", "0")
+	assertDuration_Format(t, ns8, "%q", "%!q(go_pretty_print.Duration=8ns)")
 
 	assertDuration_Format(t, -ns8, "%b", "-4835703278458517p-79")
 	assertDuration_Format(t, -ns8, "%.2b", "-4835703278458517p-79")
@@ -143,7 +658,9 @@ func TestDuration_Format_NS(t *testing.T) {
 		assertDuration_Format(t, -ns8, "%.128"+c, "-8ns")
 	}
 
-	assertDuration_Format(t, -ns8, "%d", "%!d(go_pretty_print.Duration=-8ns)")
+	assertDuration_Format(t, -ns8, "%d", "-8")
+	assertDuration_Format(t, -ns8, "%#d", "0")
+	assertDuration_Format(t, -ns8, "%q", "%!q(go_pretty_print.Duration=-8ns)")
 }
 
 func TestDuration_Format_US(t *testing.T) {
@@ -173,7 +690,9 @@ func TestDuration_Format_US(t *testing.T) {
 		assertDuration_Format(t, us7+ns8, "%.128"+c, "7us 8ns")
 	}
 
-	assertDuration_Format(t, us7, "%d", "%!d(go_pretty_print.Duration=7µs)")
+	assertDuration_Format(t, us7, "%d", "7000")
+	assertDuration_Format(t, us7, "%#d", "0")
+	assertDuration_Format(t, us7, "%q", "%!q(go_pretty_print.Duration=7µs)")
 
 	assertDuration_Format(t, -us7, "%b", "-8264141345021879p-70")
 	assertDuration_Format(t, -us7, "%.2b", "-8264141345021879p-70")
@@ -201,7 +720,9 @@ func TestDuration_Format_US(t *testing.T) {
 		assertDuration_Format(t, -us7-ns8, "%.128"+c, "-7us 8ns")
 	}
 
-	assertDuration_Format(t, -us7, "%d", "%!d(go_pretty_print.Duration=-7µs)")
+	assertDuration_Format(t, -us7, "%d", "-7000")
+	assertDuration_Format(t, -us7, "%#d", "0")
+	assertDuration_Format(t, -us7, "%q", "%!q(go_pretty_print.Duration=-7µs)")
 }
 
 func TestDuration_Format_MS(t *testing.T) {
@@ -244,7 +765,9 @@ func TestDuration_Format_MS(t *testing.T) {
 		assertDuration_Format(t, ms6+us7+ns8, "%.128"+c, "6ms 7us 8ns")
 	}
 
-	assertDuration_Format(t, ms6, "%d", "%!d(go_pretty_print.Duration=6ms)")
+	assertDuration_Format(t, ms6, "%d", "6000000")
+	assertDuration_Format(t, ms6, "%#d", "0")
+	assertDuration_Format(t, ms6, "%q", "%!q(go_pretty_print.Duration=6ms)")
 
 	assertDuration_Format(t, -ms6, "%b", "-6917529027641082p-60")
 	assertDuration_Format(t, -ms6, "%.2b", "-6917529027641082p-60")
@@ -285,7 +808,9 @@ func TestDuration_Format_MS(t *testing.T) {
 		assertDuration_Format(t, -ms6-us7-ns8, "%.128"+c, "-6ms 7us 8ns")
 	}
 
-	assertDuration_Format(t, -ms6, "%d", "%!d(go_pretty_print.Duration=-6ms)")
+	assertDuration_Format(t, -ms6, "%d", "-6000000")
+	assertDuration_Format(t, -ms6, "%#d", "0")
+	assertDuration_Format(t, -ms6, "%q", "%!q(go_pretty_print.Duration=-6ms)")
 }
 
 func TestDuration_Format_S(t *testing.T) {
@@ -321,7 +846,9 @@ func TestDuration_Format_S(t *testing.T) {
 		assertDuration_Format(t, s5+ns8, "%.128"+c, "5s 8ns")
 	}
 
-	assertDuration_Format(t, s5, "%d", "%!d(go_pretty_print.Duration=5s)")
+	assertDuration_Format(t, s5, "%d", "5000000000")
+	assertDuration_Format(t, s5, "%#d", "5")
+	assertDuration_Format(t, s5, "%q", "%!q(go_pretty_print.Duration=5s)")
 
 	assertDuration_Format(t, -s5, "%b", "-5629499534213120p-50")
 	assertDuration_Format(t, -s5, "%.2b", "-5629499534213120p-50")
@@ -355,7 +882,9 @@ func TestDuration_Format_S(t *testing.T) {
 		assertDuration_Format(t, -s5-ns8, "%.128"+c, "-5s 8ns")
 	}
 
-	assertDuration_Format(t, -s5, "%d", "%!d(go_pretty_print.Duration=-5s)")
+	assertDuration_Format(t, -s5, "%d", "-5000000000")
+	assertDuration_Format(t, -s5, "%#d", "-5")
+	assertDuration_Format(t, -s5, "%q", "%!q(go_pretty_print.Duration=-5s)")
 }
 
 func TestDuration_Format_M(t *testing.T) {
@@ -391,7 +920,9 @@ func TestDuration_Format_M(t *testing.T) {
 		assertDuration_Format(t, m4+ns8, "%.128"+c, "4m 8ns")
 	}
 
-	assertDuration_Format(t, m4, "%d", "%!d(go_pretty_print.Duration=4m0s)")
+	assertDuration_Format(t, m4, "%d", "240000000000")
+	assertDuration_Format(t, m4, "%#d", "240")
+	assertDuration_Format(t, m4, "%q", "%!q(go_pretty_print.Duration=4m0s)")
 
 	assertDuration_Format(t, -m4, "%b", "-8444249301319680p-45")
 	assertDuration_Format(t, -m4, "%.2b", "-8444249301319680p-45")
@@ -425,7 +956,9 @@ func TestDuration_Format_M(t *testing.T) {
 		assertDuration_Format(t, -m4-ns8, "%.128"+c, "-4m 8ns")
 	}
 
-	assertDuration_Format(t, -m4, "%d", "%!d(go_pretty_print.Duration=-4m0s)")
+	assertDuration_Format(t, -m4, "%d", "-240000000000")
+	assertDuration_Format(t, -m4, "%#d", "-240")
+	assertDuration_Format(t, -m4, "%q", "%!q(go_pretty_print.Duration=-4m0s)")
 }
 
 func TestDuration_Format_H(t *testing.T) {
@@ -461,7 +994,9 @@ func TestDuration_Format_H(t *testing.T) {
 		assertDuration_Format(t, h3+ns8, "%.128"+c, "3h 8ns")
 	}
 
-	assertDuration_Format(t, h3, "%d", "%!d(go_pretty_print.Duration=3h0m0s)")
+	assertDuration_Format(t, h3, "%d", "10800000000000")
+	assertDuration_Format(t, h3, "%#d", "10800")
+	assertDuration_Format(t, h3, "%q", "%!q(go_pretty_print.Duration=3h0m0s)")
 
 	assertDuration_Format(t, -h3, "%b", "-5937362789990400p-39")
 	assertDuration_Format(t, -h3, "%.2b", "-5937362789990400p-39")
@@ -495,7 +1030,9 @@ func TestDuration_Format_H(t *testing.T) {
 		assertDuration_Format(t, -h3-ns8, "%.128"+c, "-3h 8ns")
 	}
 
-	assertDuration_Format(t, -h3, "%d", "%!d(go_pretty_print.Duration=-3h0m0s)")
+	assertDuration_Format(t, -h3, "%d", "-10800000000000")
+	assertDuration_Format(t, -h3, "%#d", "-10800")
+	assertDuration_Format(t, -h3, "%q", "%!q(go_pretty_print.Duration=-3h0m0s)")
 }
 
 func TestDuration_Format_D(t *testing.T) {
@@ -531,7 +1068,9 @@ func TestDuration_Format_D(t *testing.T) {
 		assertDuration_Format(t, d2+ns8, "%.128"+c, "2d 8ns")
 	}
 
-	assertDuration_Format(t, d2, "%d", "%!d(go_pretty_print.Duration=48h0m0s)")
+	assertDuration_Format(t, d2, "%d", "172800000000000")
+	assertDuration_Format(t, d2, "%#d", "172800")
+	assertDuration_Format(t, d2, "%q", "%!q(go_pretty_print.Duration=48h0m0s)")
 
 	assertDuration_Format(t, -d2, "%b", "-5937362789990400p-35")
 	assertDuration_Format(t, -d2, "%.2b", "-5937362789990400p-35")
@@ -565,7 +1104,9 @@ func TestDuration_Format_D(t *testing.T) {
 		assertDuration_Format(t, -d2-ns8, "%.128"+c, "-2d 8ns")
 	}
 
-	assertDuration_Format(t, -d2, "%d", "%!d(go_pretty_print.Duration=-48h0m0s)")
+	assertDuration_Format(t, -d2, "%d", "-172800000000000")
+	assertDuration_Format(t, -d2, "%#d", "-172800")
+	assertDuration_Format(t, -d2, "%q", "%!q(go_pretty_print.Duration=-48h0m0s)")
 }
 
 func TestDuration_Format_W(t *testing.T) {
@@ -601,7 +1142,9 @@ func TestDuration_Format_W(t *testing.T) {
 		assertDuration_Format(t, w1+ns8, "%.128"+c, "1w 8ns")
 	}
 
-	assertDuration_Format(t, w1, "%d", "%!d(go_pretty_print.Duration=168h0m0s)")
+	assertDuration_Format(t, w1, "%d", "604800000000000")
+	assertDuration_Format(t, w1, "%#d", "604800")
+	assertDuration_Format(t, w1, "%q", "%!q(go_pretty_print.Duration=168h0m0s)")
 
 	assertDuration_Format(t, -w1, "%b", "-5195192441241600p-33")
 	assertDuration_Format(t, -w1, "%.2b", "-5195192441241600p-33")
@@ -635,7 +1178,182 @@ func TestDuration_Format_W(t *testing.T) {
 		assertDuration_Format(t, -w1-ns8, "%.128"+c, "-1w 8ns")
 	}
 
-	assertDuration_Format(t, -w1, "%d", "%!d(go_pretty_print.Duration=-168h0m0s)")
+	assertDuration_Format(t, -w1, "%d", "-604800000000000")
+	assertDuration_Format(t, -w1, "%#d", "-604800")
+	assertDuration_Format(t, -w1, "%q", "%!q(go_pretty_print.Duration=-168h0m0s)")
+}
+
+func TestDuration_Format_Long(t *testing.T) {
+	assertDuration_Format(t, 0, "%#s", "0 seconds")
+	assertDuration_Format(t, time.Hour, "%#s", "1 hour")
+	assertDuration_Format(t, w1+d2+h3, "%#s", "1 week 2 days")
+	assertDuration_Format(t, w1+d2+h3, "%#.0s", "1 week")
+	assertDuration_Format(t, w1+d2+h3, "%#.2s", "1 week 2 days 3 hours")
+	assertDuration_Format(t, -ms6-us7-ns8, "%#.7s", "-6 milliseconds 7 microseconds 8 nanoseconds")
+}
+
+func TestDuration_Format_Flags(t *testing.T) {
+	for _, c := range [2]string{"s", "v"} {
+		assertDuration_Format(t, w1+d2, "%10"+c, "     1w 2d")
+		assertDuration_Format(t, w1+d2, "%-10"+c, "1w 2d     ")
+		assertDuration_Format(t, w1+d2, "%010"+c, "000001w 2d")
+		assertDuration_Format(t, w1+d2, "%-010"+c, "1w 2d     ")
+		assertDuration_Format(t, w1+d2, "%+"+c, "+1w 2d")
+		assertDuration_Format(t, w1+d2, "% "+c, " 1w 2d")
+		assertDuration_Format(t, w1+d2, "%+ "+c, "+1w 2d")
+		assertDuration_Format(t, w1+d2, "%+10"+c, "    +1w 2d")
+		assertDuration_Format(t, w1+d2, "%+-10"+c, "+1w 2d    ")
+		assertDuration_Format(t, w1+d2, "%+010"+c, "+00001w 2d")
+		assertDuration_Format(t, w1+d2, "%3"+c, "1w 2d")
+		assertDuration_Format(t, w1+d2+h3, "%12.2"+c, "    1w 2d 3h")
+
+		assertDuration_Format(t, -w1-d2, "%10"+c, "    -1w 2d")
+		assertDuration_Format(t, -w1-d2, "%-10"+c, "-1w 2d    ")
+		assertDuration_Format(t, -w1-d2, "%010"+c, "-00001w 2d")
+		assertDuration_Format(t, -w1-d2, "%+"+c, "-1w 2d")
+		assertDuration_Format(t, -w1-d2, "% "+c, "-1w 2d")
+
+		assertDuration_Format(t, 0, "%+"+c, "+0s")
+		assertDuration_Format(t, 0, "%5"+c, "   0s")
+	}
+
+	assertDuration_Format(t, time.Hour, "%-#10s|", "1 hour    |")
+	assertDuration_Format(t, time.Hour, "%+#s", "+1 hour")
+
+	assertDuration_Format(t, s5, "%8.2f", "    5.00")
+	assertDuration_Format(t, s5, "%-8.2f|", "5.00    |")
+	assertDuration_Format(t, s5, "%08.2f", "00005.00")
+	assertDuration_Format(t, s5, "%+.2f", "+5.00")
+	assertDuration_Format(t, s5, "% .2f", " 5.00")
+	assertDuration_Format(t, s5, "%+08.2f", "+0005.00")
+	assertDuration_Format(t, s5, "%+g", "+5")
+	assertDuration_Format(t, s5, "%+10e", "    +5e+00")
+	assertDuration_Format(t, -s5, "%8.2f", "   -5.00")
+	assertDuration_Format(t, -s5, "%08.2f", "-0005.00")
+	assertDuration_Format(t, -s5, "%+08.2f", "-0005.00")
+	assertDuration_Format(t, -s5, "% f", "-5")
+	assertDuration_Format(t, 0, "%+f", "+0")
+
+	assertDuration_Format(t, h3, "%10c", "   3:00:00")
+	assertDuration_Format(t, h3, "%010c", "0003:00:00")
+	assertDuration_Format(t, -h3, "%010c", "-003:00:00")
+	assertDuration_Format(t, h3, "%+c", "+3:00:00")
+	assertDuration_Format(t, h3, "%-10c|", "3:00:00   |")
+
+	assertDuration_Format(t, time.Hour, "%10q", "%!q(go_pretty_print.Duration=1h0m0s)")
+}
+
+func TestDuration_Format_Int(t *testing.T) {
+	assertDuration_Format(t, w1+ns8, "%d", "604800000000008")
+	assertDuration_Format(t, w1+ns8, "%#d", "604800")
+	assertDuration_Format(t, -w1-ns8, "%#d", "-604800")
+	assertDuration_Format(t, 1500*time.Millisecond, "%#d", "1")
+	assertDuration_Format(t, -1500*time.Millisecond, "%#d", "-1")
+
+	assertDuration_Format(t, 255, "%x", "ff")
+	assertDuration_Format(t, 255, "%X", "FF")
+	assertDuration_Format(t, 255, "%#x", "0xff")
+	assertDuration_Format(t, 255, "%#X", "0XFF")
+	assertDuration_Format(t, -255, "%#x", "-0xff")
+	assertDuration_Format(t, 8, "%o", "10")
+	assertDuration_Format(t, 8, "%#o", "010")
+	assertDuration_Format(t, 0, "%#o", "0")
+	assertDuration_Format(t, 8, "%O", "0o10")
+	assertDuration_Format(t, -8, "%O", "-0o10")
+
+	assertDuration_Format(t, 42, "%6d", "    42")
+	assertDuration_Format(t, 42, "%-6d|", "42    |")
+	assertDuration_Format(t, 42, "%06d", "000042")
+	assertDuration_Format(t, -42, "%06d", "-00042")
+	assertDuration_Format(t, 42, "%+06d", "+00042")
+	assertDuration_Format(t, 42, "% d", " 42")
+	assertDuration_Format(t, 42, "%.4d", "0042")
+	assertDuration_Format(t, 42, "%6.4d", "  0042")
+	assertDuration_Format(t, 42, "%06.4d", "  0042")
+	assertDuration_Format(t, 0, "%.0d", "")
+	assertDuration_Format(t, 255, "%#08x", "0x0000ff")
+	assertDuration_Format(t, 255, "%#8x", "    0xff")
+	assertDuration_Format(t, s5, "%#6d", "     5")
+
+	assertDuration_Format(t, math.MaxInt64, "%d", "9223372036854775807")
+	assertDuration_Format(t, math.MinInt64, "%d", "-9223372036854775808")
+	assertDuration_Format(t, math.MinInt64, "%x", "-8000000000000000")
+	assertDuration_Format(t, math.MinInt64, "%#d", "-9223372036")
+}
+
+func TestDuration_Format_Boundaries(t *testing.T) {
+	for _, c := range [...]struct {
+		format   string
+		max, min string
+	}{
+		{"%b", "4835703278458517p-19", "-4835703278458517p-19"},
+		{"%e", "9.223372036854776e+09", "-9.223372036854776e+09"},
+		{"%.3E", "9.223E+09", "-9.223E+09"},
+		{"%f", "9223372036.854776", "-9223372036.854776"},
+		{"%.2f", "9223372036.85", "-9223372036.85"},
+		{"%g", "9.223372036854776e+09", "-9.223372036854776e+09"},
+		{"%G", "9.223372036854776E+09", "-9.223372036854776E+09"},
+		{"%+.0f", "+9223372037", "-9223372037"},
+		{"%s", "15250w 1d", "-15250w 1d"},
+		{"%v", "15250w 1d", "-15250w 1d"},
+		{"%.0s", "15250w", "-15250w"},
+		{"%.7v", "15250w 1d 23h 47m 16s 854ms 775us 807ns", "-15250w 1d 23h 47m 16s 854ms 775us 808ns"},
+		{"%.128s", "15250w 1d 23h 47m 16s 854ms 775us 807ns", "-15250w 1d 23h 47m 16s 854ms 775us 808ns"},
+		{"%#s", "15250 weeks 1 day", "-15250 weeks 1 day"},
+		{
+			"%#.7s",
+			"15250 weeks 1 day 23 hours 47 minutes 16 seconds 854 milliseconds 775 microseconds 807 nanoseconds",
+			"-15250 weeks 1 day 23 hours 47 minutes 16 seconds 854 milliseconds 775 microseconds 808 nanoseconds",
+		},
+		{
+			"%#v",
+			"go_pretty_print.Duration(15250*7*24*time.Hour + 24*time.Hour + 23*time.Hour + 47*time.Minute + 16*time.Second + 854*time.Millisecond + 775*time.Microsecond + 807*time.Nanosecond)",
+			"go_pretty_print.Duration(-15250*7*24*time.Hour - 24*time.Hour - 23*time.Hour - 47*time.Minute - 16*time.Second - 854*time.Millisecond - 775*time.Microsecond - 808*time.Nanosecond)",
+		},
+		{"%c", "2562047:47:16", "-2562047:47:16"},
+		{"%.9c", "2562047:47:16.854775807", "-2562047:47:16.854775808"},
+		{"%#.9c", "106751d 23:47:16.854775807", "-106751d 23:47:16.854775808"},
+		{"%d", "9223372036854775807", "-9223372036854775808"},
+		{"%+d", "+9223372036854775807", "-9223372036854775808"},
+		{"%#d", "9223372036", "-9223372036"},
+		{"%x", "7fffffffffffffff", "-8000000000000000"},
+		{"%#X", "0X7FFFFFFFFFFFFFFF", "-0X8000000000000000"},
+		{"%#o", "0777777777777777777777", "-01000000000000000000000"},
+		{"%O", "0o777777777777777777777", "-0o1000000000000000000000"},
+		{"%q", "%!q(go_pretty_print.Duration=2562047h47m16.854775807s)", "%!q(go_pretty_print.Duration=-2562047h47m16.854775808s)"},
+		{"%12s", "   15250w 1d", "  -15250w 1d"},
+		{"%012s", "00015250w 1d", "-0015250w 1d"},
+	} {
+		assertDuration_Format(t, math.MaxInt64, c.format, c.max)
+		assertDuration_Format(t, math.MinInt64, c.format, c.min)
+	}
+
+	for _, c := range [...]struct {
+		rounding Rounding
+		max, min string
+	}{
+		{Truncate, "15250w", "-15250w"},
+		{Floor, "15250w", "-15251w"},
+		{Ceiling, "15251w", "-15250w"},
+		{HalfUp, "15250w", "-15250w"},
+		{HalfEven, "15250w", "-15250w"},
+	} {
+		assertRoundedDuration_Format(t, math.MaxInt64, c.rounding, "%.0s", c.max)
+		assertRoundedDuration_Format(t, math.MinInt64, c.rounding, "%.0s", c.min)
+	}
+}
+
+func TestDuration_Boundaries(t *testing.T) {
+	for _, d := range [...]time.Duration{math.MaxInt64, math.MinInt64, math.MinInt64 + 1, 1<<50 + 1, -1<<50 - 1} {
+		text, _ := Duration(d).MarshalText()
+		assertDuration_UnmarshalText(t, string(text), d, nil)
+		assertParseDuration(t, string(text), d)
+		assertParseClock(t, Duration(d).Clock(9, true), d)
+		assertParseISO8601(t, Duration(d).ISO8601(), d)
+
+		jsn, _ := json.Marshal(Duration(d))
+		assertDuration_UnmarshalJSON(t, string(jsn), d)
+	}
 }
 
 func assertDuration_Format(t *testing.T, d time.Duration, format, expected string) {